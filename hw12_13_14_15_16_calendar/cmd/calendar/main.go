@@ -10,12 +10,19 @@ import (
 	"time"
 
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/calendar"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/scheduler"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/logger"
 	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server"
 	internalgrpc "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server/grpc"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server/grpc/interceptors"
 	internalhttp "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server/http"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server/http/feed"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server/http/gateway"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server/http/middleware"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server/http/reminders"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
 	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
 	sqlstorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/sql"
@@ -49,7 +56,11 @@ func main() {
 		log.Fatal().Err(err).Msg("failed to configure logging")
 	}
 
-	var repo storage.IRepository
+	// планировщику, запускаемому с очередью сообщений в памяти процесса, нужен тот же репозиторий
+	var repo interface {
+		storage.IRepository
+		scheduler.IRepository
+	}
 
 	switch cfg.Database.DBType {
 	case config.DBTypeSQL:
@@ -61,13 +72,22 @@ func main() {
 		log.Fatal().Msg("invalid config value for db_type")
 	}
 
+	switch cfg.CalendarConfig.OverlapPolicy {
+	case config.OverlapPolicyReject, config.OverlapPolicyAllow, config.OverlapPolicyAllowTentative:
+	case "":
+		cfg.CalendarConfig.OverlapPolicy = config.OverlapPolicyAllow
+	default:
+		cancel()
+		log.Fatal().Msg("invalid config value for overlap_policy")
+	}
+
 	if err = repo.Connect(ctx, ""); err != nil { // Empty DNS for PG to process environment variables
 		cancel()
 		log.Fatal().Err(err).Msg("failed to connect to DB")
 	}
 	defer repo.Close()
 
-	calendarApp := calendar.New(repo)
+	calendarApp := calendar.New(repo, cfg.CalendarConfig)
 
 	file, err := os.OpenFile("server.log", os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o664)
 	if err != nil {
@@ -79,7 +99,18 @@ func main() {
 
 	servLogger := syslog.New(file, "", 0)
 
-	serverGRPC := internalgrpc.NewServer(servLogger, calendarApp)
+	var verifier interceptors.ITokenVerifier
+
+	if cfg.AuthConfig.Enabled() {
+		if verifier, err = auth.NewVerifier(cfg.AuthConfig); err != nil {
+			cancel()
+			repo.Close()
+			file.Close()
+			log.Fatal().Err(err).Msg("failed to configure authentication")
+		}
+	}
+
+	serverGRPC := internalgrpc.NewServer(servLogger, calendarApp, verifier)
 
 	wg := sync.WaitGroup{}
 
@@ -100,11 +131,34 @@ func main() {
 		serverGRPC.Stop()
 	}()
 
+	reminderHub := reminders.NewHub(cfg.RemindersConfig.Backlog, cfg.RemindersConfig.BacklogTTL)
+
+	switch cfg.MessageQueueConfig.Type {
+	case config.MQTypeRabbitMQ, "":
+		// планировщик и рассыльщик запускаются отдельными сервисами
+	case config.MQTypeInMemory:
+		if err = runNotifications(ctx, &wg, repo, reminderHub, cfg); err != nil {
+			cancel()
+			repo.Close()
+			file.Close()
+			log.Fatal().Err(err).Msg("failed to run notification pipeline")
+		}
+	default:
+		cancel()
+		repo.Close()
+		file.Close()
+		log.Fatal().Msg("invalid config value for amqp type")
+	}
+
 	handler := chi.NewRouter()
 	handler.Use(middleware.NewLoggingMiddleware(servLogger))
 	handler.Use(chimiddleware.Recoverer)
 
-	gwmux := runtime.NewServeMux()
+	// заголовок Authorization передается в grpc метаданных authorization без дополнительной настройки
+	gwmux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(gateway.MIMEICal, gateway.NewICalMarshaler()),
+		runtime.WithMarshalerOption(gateway.MIMENDJSON, gateway.NewNDJSONMarshaler()),
+	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 	if err = eventspb.RegisterEventsHandlerFromEndpoint(ctx, gwmux, cfg.GRPCConfig.GetAddr(), opts); err != nil {
 		cancel()
@@ -113,9 +167,18 @@ func main() {
 		log.Fatal().Err(err).Msgf("failed to dial to %q", cfg.GRPCConfig.GetAddr())
 	}
 
+	handler.Get(server.ICalFeedRoute, feed.NewHandler(calendarApp))
+	// поток напоминаний отдает пользователя только по проверенному токену, поэтому без аутентификации выключен
+	if verifier != nil {
+		handler.With(middleware.NewAuthMiddleware(verifier)).
+			Get(server.RemindersStreamRoute, reminders.NewStreamHandler(reminderHub, cfg.RemindersConfig.Heartbeat))
+	}
+	if cfg.RemindersConfig.Secret != "" {
+		handler.Post(server.RemindersIngestRoute, reminders.NewIngestHandler(reminderHub, cfg.RemindersConfig.Secret))
+	}
 	handler.Handle("/*", gwmux)
 
-	server := internalhttp.NewServer(cfg.HTTPConfig.GetAddr(), handler)
+	serverHTTP := internalhttp.NewServer(cfg.HTTPConfig.GetAddr(), handler)
 
 	wg.Add(1)
 	go func() {
@@ -126,12 +189,12 @@ func main() {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
 		defer cancel()
 
-		if err := server.Stop(ctx); err != nil {
+		if err := serverHTTP.Stop(ctx); err != nil {
 			log.Error().Err(err).Msg("failed to stop HTTP server")
 		}
 	}()
 
-	if err = server.Start(ctx); err != nil {
+	if err = serverHTTP.Start(ctx); err != nil {
 		cancel()
 		repo.Close()
 		file.Close()
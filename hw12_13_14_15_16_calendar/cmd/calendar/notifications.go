@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/scheduler"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender/adapters"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender/notifiers"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/clients/memorymq"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// runNotifications запускает планировщик и рассыльщик внутри процесса календаря поверх очереди
+// сообщений в памяти процесса. Доставленные напоминания также публикуются в SSE поток reminderHub.
+// Оба приложения останавливаются при отмене ctx.
+func runNotifications(
+	ctx context.Context,
+	wg *sync.WaitGroup,
+	repo scheduler.IRepository,
+	reminderHub sender.INotifier,
+	cfg *config.Config,
+) error {
+	router, err := notifiers.New(cfg.SenderConfig, os.Stdout)
+	if err != nil {
+		return errors.Wrap(err, "failed to configure notification channels")
+	}
+
+	notifier := notifiers.NewTee(router, reminderHub)
+
+	broker := memorymq.NewBroker(cfg.MessageQueueConfig)
+	schedulerApp := scheduler.NewApp(repo, broker, cfg.SchedulerConfig)
+	senderApp := sender.NewApp(adapters.NewMemoryClient(broker), notifier)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if inErr := senderApp.Run(ctx); inErr != nil {
+			log.Error().Err(inErr).Msg("failed to run sender")
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if inErr := schedulerApp.Run(ctx); inErr != nil && !errors.Is(inErr, context.Canceled) {
+			log.Error().Err(inErr).Msg("failed to run scheduler")
+		}
+
+		if inErr := schedulerApp.Stop(); inErr != nil {
+			log.Error().Err(inErr).Msg("failed to stop in-memory message queue")
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/logger"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/clients/rabbitmq"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/pflag"
+)
+
+// deadLetter представление недоставленного сообщения в выводе команды list.
+type deadLetter struct {
+	Attempts int       `json:"attempts"`
+	Reason   string    `json:"reason,omitempty"`
+	DeadAt   time.Time `json:"dead_at,omitempty"`
+	Body     string    `json:"body"`
+}
+
+// Административная команда для очереди недоставленных уведомлений: list выводит до --limit
+// сообщений в формате JSON Lines, не удаляя их, replay возвращает их в основную очередь.
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(),
+		syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer cancel()
+
+	limit := pflag.Int("limit", 100, "Maximum number of dead-lettered messages to process")
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		cancel()
+		log.Fatal().Err(err).Msg("failed to get service config") //nolint:gocritic
+	}
+
+	if err = logger.ConfigureLogging(cfg.Logger); err != nil {
+		cancel()
+		log.Fatal().Err(err).Msg("failed to configure logging")
+	}
+
+	rmq, err := rabbitmq.NewClient(cfg.MessageQueueConfig)
+	if err != nil {
+		cancel()
+		log.Fatal().Err(err).Msg("failed to create amqp client")
+	}
+	defer rmq.Close()
+
+	switch pflag.Arg(0) {
+	case "list":
+		var deadLetters []*rabbitmq.DeadLetter
+
+		if deadLetters, err = rmq.ListDeadLetters(ctx, *limit); err != nil {
+			cancel()
+			log.Fatal().Err(err).Msg("failed to list dead-lettered messages")
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		for _, dl := range deadLetters {
+			_ = encoder.Encode(deadLetter{
+				Attempts: dl.Attempts,
+				Reason:   dl.Reason,
+				DeadAt:   dl.DeadAt,
+				Body:     string(dl.Body),
+			})
+		}
+	case "replay":
+		var replayed int
+
+		if replayed, err = rmq.ReplayDeadLetters(ctx, *limit); err != nil {
+			cancel()
+			log.Fatal().Err(err).Int("replayed", replayed).Msg("failed to replay dead-lettered messages")
+		}
+
+		log.Info().Int("replayed", replayed).Msg("dead-lettered messages replayed")
+	default:
+		cancel()
+		log.Fatal().Msg("usage: calendar_dlq [--limit=N] list|replay")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/scheduler"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/logger"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/clients/natsjs"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/clients/rabbitmq"
 	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
 	sqlstorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/sql"
@@ -30,10 +31,24 @@ func main() {
 		log.Fatal().Err(err).Msg("failed to configure logging")
 	}
 
-	rmq, err := rabbitmq.NewClient(cfg.MessageQueueConfig.URL, cfg.MessageQueueConfig.Queue)
+	var publisher scheduler.IPublisherMQ
+
+	switch cfg.MessageQueueConfig.Type {
+	case config.MQTypeRabbitMQ, "":
+		publisher, err = rabbitmq.NewClient(cfg.MessageQueueConfig)
+	case config.MQTypeNATS:
+		publisher, err = natsjs.NewClient(cfg.MessageQueueConfig)
+	case config.MQTypeInMemory:
+		cancel()
+		log.Fatal().Msg("in-memory message queue runs inside calendar service only")
+	default:
+		cancel()
+		log.Fatal().Msg("invalid config value for amqp type")
+	}
+
 	if err != nil {
 		cancel()
-		log.Fatal().Err(err).Msg("failed to create amqp client")
+		log.Fatal().Err(err).Msg("failed to create message queue client")
 	}
 
 	var repo scheduler.IRepository
@@ -54,7 +69,7 @@ func main() {
 	}
 	defer repo.Close()
 
-	app := scheduler.NewApp(repo, rmq)
+	app := scheduler.NewApp(repo, publisher, cfg.SchedulerConfig)
 
 	go func() {
 		<-ctx.Done()
@@ -64,7 +79,7 @@ func main() {
 		}
 	}()
 
-	if err = app.Run(ctx, cfg.SchedulerConfig.DBReadInterval); err != nil {
+	if err = app.Run(ctx); err != nil {
 		cancel()
 		log.Fatal().Err(err).Msg("failed to run scheduler")
 	}
@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"os"
 	"os/signal"
 	"syscall"
 
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender/adapters"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender/notifiers"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/logger"
 	"github.com/rs/zerolog/log"
@@ -28,13 +30,33 @@ func main() {
 		log.Fatal().Err(err).Msg("failed to configure logging")
 	}
 
-	rmq, err := adapters.NewRabbitMQClient(cfg.MessageQueueConfig.URL, cfg.MessageQueueConfig.Queue)
+	var consumer sender.IConsumerMQ
+
+	switch cfg.MessageQueueConfig.Type {
+	case config.MQTypeRabbitMQ, "":
+		consumer, err = adapters.NewRabbitMQClient(cfg.MessageQueueConfig)
+	case config.MQTypeNATS:
+		consumer, err = adapters.NewNATSClient(cfg.MessageQueueConfig)
+	case config.MQTypeInMemory:
+		cancel()
+		log.Fatal().Msg("in-memory message queue runs inside calendar service only")
+	default:
+		cancel()
+		log.Fatal().Msg("invalid config value for amqp type")
+	}
+
+	if err != nil {
+		cancel()
+		log.Fatal().Err(err).Msg("failed to create message queue client")
+	}
+
+	notifier, err := notifiers.New(cfg.SenderConfig, os.Stdout)
 	if err != nil {
 		cancel()
-		log.Fatal().Err(err).Msg("failed to create amqp client")
+		log.Fatal().Err(err).Msg("failed to configure notification channels")
 	}
 
-	app := sender.NewApp(rmq)
+	app := sender.NewApp(consumer, notifier)
 
 	go func() {
 		<-ctx.Done()
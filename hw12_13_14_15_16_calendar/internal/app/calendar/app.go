@@ -1,17 +1,22 @@
 package calendar
 
 import (
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
 )
 
 // App структура основного приложения.
 type App struct {
 	repo storage.IRepository
+	cfg  config.CalendarConfig
+	hub  *hub
 }
 
 // New конструктор основного приложения.
-func New(repo storage.IRepository) *App {
+func New(repo storage.IRepository, cfg config.CalendarConfig) *App {
 	return &App{
 		repo: repo,
+		cfg:  cfg,
+		hub:  newHub(),
 	}
 }
@@ -0,0 +1,68 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// actorOf возвращает пользователя, выполняющего вызов: subject токена, а без аутентификации -
+// пользователя requestUserID, от имени которого пришел запрос.
+func actorOf(ctx context.Context, requestUserID string) string {
+	if subject, ok := auth.SubjectFromContext(ctx); ok {
+		return subject
+	}
+
+	return requestUserID
+}
+
+// authorize проверяет, что пользователь, выполняющий вызов от имени requestUserID, имеет доступ
+// permission к календарю ownerID: является его владельцем или получил разрешение не ниже permission.
+func (a *App) authorize(ctx context.Context, requestUserID, ownerID string, permission storage.Permission) error {
+	actorID := actorOf(ctx, requestUserID)
+	if actorID == ownerID {
+		return nil
+	}
+
+	grant, err := a.repo.ReadGrant(ctx, ownerID, actorID)
+	if err != nil {
+		if errors.Is(err, storage.ErrGrantNotFound) {
+			return errors.Wrapf(app.ErrPermissionDenied, "no access to calendar of user %q", ownerID)
+		}
+
+		return errors.Wrap(err, "failed to read grant")
+	}
+
+	if !grant.Allows(permission) {
+		return errors.Wrapf(app.ErrPermissionDenied, "%s access to calendar of user %q is required", permission, ownerID)
+	}
+
+	return nil
+}
+
+// authorizeOwner проверяет, что вызов выполняет сам пользователь ownerID.
+func authorizeOwner(ctx context.Context, ownerID string) error {
+	if actorOf(ctx, ownerID) != ownerID {
+		return errors.Wrapf(app.ErrPermissionDenied, "only user %q can do this", ownerID)
+	}
+
+	return nil
+}
+
+// authorizeChange проверяет, что пользователь, выполняющий вызов от имени userID, может изменять
+// событие eventID, и возвращает текущее состояние события.
+func (a *App) authorizeChange(ctx context.Context, userID, eventID string) (*storage.Event, error) {
+	event, err := a.repo.ReadEvent(ctx, eventID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read event by ID %q", eventID)
+	}
+
+	if err = a.authorize(ctx, userID, event.UserID, storage.PermissionWrite); err != nil {
+		return nil, errors.Wrapf(err, "event %q", eventID)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,55 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnership(t *testing.T) {
+	ctx := context.Background()
+	repo := memorystorage.New()
+	calendar := New(repo, config.CalendarConfig{OverlapPolicy: config.OverlapPolicyAllow})
+
+	startsAt := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(time.Hour)
+
+	require.NoError(t, calendar.CreateEvent(ctx, "Standup", "", "alice", "", &startsAt, &endsAt, 0, "", nil, false))
+
+	events, err := calendar.ReadDailyEvents(ctx, "alice", startsAt, "", nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	eventID := events[0].ID
+
+	t.Run("other user can't modify event", func(t *testing.T) {
+		err := calendar.UpdateEvent(ctx, eventID, "Stolen", "", "bob", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+
+		require.ErrorIs(t, calendar.DeleteEvent(ctx, "bob", eventID), app.ErrPermissionDenied)
+
+		event, err := repo.ReadEvent(ctx, eventID)
+		require.NoError(t, err)
+		require.Equal(t, "Standup", event.Title)
+		require.Equal(t, "alice", event.UserID)
+	})
+
+	t.Run("owner modifies event", func(t *testing.T) {
+		err := calendar.UpdateEvent(ctx, eventID, "Retro", "", "alice", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.NoError(t, err)
+
+		require.NoError(t, calendar.DeleteEvent(ctx, "alice", eventID))
+	})
+
+	t.Run("missing event", func(t *testing.T) {
+		err := calendar.UpdateEvent(ctx, eventID, "Retro", "", "alice", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.ErrorIs(t, err, app.ErrEventNotFound)
+
+		require.ErrorIs(t, calendar.DeleteEvent(ctx, "alice", eventID), app.ErrEventNotFound)
+	})
+}
@@ -0,0 +1,185 @@
+package calendar
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// defaultCalendarName название календаря по умолчанию, создаваемого при первом обращении к календарям пользователя.
+const defaultCalendarName = "Default"
+
+// colorPattern формат цвета календаря.
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// CreateCalendar метод создания именованного календаря пользователя с цветом в формате #RRGGBB
+// и напоминанием по умолчанию для его событий.
+func (a *App) CreateCalendar(
+	ctx context.Context,
+	userID, name, color string,
+	defaultNotifyInterval time.Duration,
+) (*storage.Calendar, error) {
+	if err := authorizeOwner(ctx, userID); err != nil {
+		return nil, errors.Wrap(err, "[app::CreateCalendar]")
+	}
+
+	calendar := storage.Calendar{
+		UserID:                userID,
+		Name:                  strings.TrimSpace(name),
+		Color:                 color,
+		DefaultNotifyInterval: defaultNotifyInterval,
+		CreatedAt:             time.Now().UTC(),
+	}
+
+	if err := validateCalendar(&calendar); err != nil {
+		return nil, errors.Wrap(err, "[app::CreateCalendar]")
+	}
+
+	if err := a.repo.CreateCalendar(ctx, &calendar); err != nil {
+		return nil, errors.Wrapf(err, "[app::CreateCalendar]: failed to create calendar of user %q", userID)
+	}
+
+	return &calendar, nil
+}
+
+// UpdateCalendar метод изменения названия, цвета и напоминания по умолчанию календаря пользователя.
+func (a *App) UpdateCalendar(
+	ctx context.Context,
+	userID, calendarID, name, color string,
+	defaultNotifyInterval time.Duration,
+) error {
+	calendar, err := a.ownCalendar(ctx, userID, calendarID)
+	if err != nil {
+		return errors.Wrap(err, "[app::UpdateCalendar]")
+	}
+
+	calendar.Name = strings.TrimSpace(name)
+	calendar.Color = color
+	calendar.DefaultNotifyInterval = defaultNotifyInterval
+
+	if err = validateCalendar(calendar); err != nil {
+		return errors.Wrap(err, "[app::UpdateCalendar]")
+	}
+
+	return errors.Wrapf(a.repo.UpdateCalendar(ctx, calendar),
+		"[app::UpdateCalendar]: failed to update calendar %q", calendarID)
+}
+
+// DeleteCalendar метод удаления календаря пользователя вместе с его событиями.
+// Календарь по умолчанию удалить нельзя. Об удалении каждого события публикуется изменение.
+func (a *App) DeleteCalendar(ctx context.Context, userID, calendarID string) error {
+	calendar, err := a.ownCalendar(ctx, userID, calendarID)
+	if err != nil {
+		return errors.Wrap(err, "[app::DeleteCalendar]")
+	}
+
+	if calendar.IsDefault {
+		return errors.Wrap(app.ErrInvalidCalendar, "[app::DeleteCalendar]: default calendar can't be deleted")
+	}
+
+	deleted, err := a.repo.DeleteCalendar(ctx, calendarID)
+	if err != nil {
+		return errors.Wrapf(err, "[app::DeleteCalendar]: failed to delete calendar %q", calendarID)
+	}
+
+	for _, event := range deleted {
+		a.notifyChange(ctx, app.ChangeTypeDeleted, event)
+	}
+
+	return nil
+}
+
+// ListCalendars метод получения календарей пользователя. Календарь по умолчанию создается,
+// если его еще нет, и идет в списке первым.
+func (a *App) ListCalendars(ctx context.Context, userID string) ([]*storage.Calendar, error) {
+	if err := a.authorize(ctx, userID, userID, storage.PermissionRead); err != nil {
+		return nil, errors.Wrap(err, "[app::ListCalendars]")
+	}
+
+	if _, err := a.defaultCalendar(ctx, userID); err != nil {
+		return nil, errors.Wrap(err, "[app::ListCalendars]")
+	}
+
+	calendars, err := a.repo.ListCalendars(ctx, userID)
+
+	return calendars, errors.Wrapf(err, "[app::ListCalendars]: failed to list calendars of user %q", userID)
+}
+
+// ownCalendar проверяет, что вызов выполняет сам пользователь userID, и читает его календарь calendarID.
+func (a *App) ownCalendar(ctx context.Context, userID, calendarID string) (*storage.Calendar, error) {
+	if err := authorizeOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return a.userCalendar(ctx, userID, calendarID)
+}
+
+// eventCalendar возвращает календарь calendarID владельца события ownerID, а если calendarID
+// не задан - его календарь по умолчанию.
+func (a *App) eventCalendar(ctx context.Context, ownerID, calendarID string) (*storage.Calendar, error) {
+	if calendarID == "" {
+		return a.defaultCalendar(ctx, ownerID)
+	}
+
+	return a.userCalendar(ctx, ownerID, calendarID)
+}
+
+// userCalendar читает календарь calendarID, принадлежащий пользователю userID. Календари других
+// пользователей считаются несуществующими.
+func (a *App) userCalendar(ctx context.Context, userID, calendarID string) (*storage.Calendar, error) {
+	calendar, err := a.repo.ReadCalendar(ctx, calendarID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read calendar %q", calendarID)
+	}
+
+	if calendar.UserID != userID {
+		return nil, errors.Wrapf(app.ErrCalendarNotFound, "calendar %q of user %q", calendarID, userID)
+	}
+
+	return calendar, nil
+}
+
+// defaultCalendar возвращает календарь пользователя по умолчанию, создавая его при первом обращении.
+func (a *App) defaultCalendar(ctx context.Context, userID string) (*storage.Calendar, error) {
+	calendar, err := a.repo.ReadDefaultCalendar(ctx, userID)
+	if err == nil || !errors.Is(err, storage.ErrCalendarNotFound) {
+		return calendar, errors.Wrap(err, "failed to read default calendar")
+	}
+
+	calendar = &storage.Calendar{
+		UserID:    userID,
+		Name:      defaultCalendarName,
+		IsDefault: true,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	if err = a.repo.CreateCalendar(ctx, calendar); err != nil {
+		// календарь по умолчанию мог создать параллельный запрос
+		if errors.Is(err, storage.ErrCalendarExists) {
+			calendar, err = a.repo.ReadDefaultCalendar(ctx, userID)
+		}
+
+		return calendar, errors.Wrap(err, "failed to create default calendar")
+	}
+
+	return calendar, nil
+}
+
+// validateCalendar проверяет название, цвет и напоминание по умолчанию календаря.
+func validateCalendar(calendar *storage.Calendar) error {
+	switch {
+	case calendar.Name == "":
+		return errors.Wrap(app.ErrInvalidCalendar, "name is required")
+	case calendar.Color != "" && !colorPattern.MatchString(calendar.Color):
+		return errors.Wrapf(app.ErrInvalidCalendar, "color %q is not in #RRGGBB format", calendar.Color)
+	case calendar.DefaultNotifyInterval < 0:
+		return errors.Wrap(app.ErrInvalidCalendar, "default notify interval is negative")
+	}
+
+	return nil
+}
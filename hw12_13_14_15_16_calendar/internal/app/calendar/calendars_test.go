@@ -0,0 +1,90 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendars(t *testing.T) {
+	ctx := context.Background()
+	alice := auth.WithSubject(ctx, "alice")
+	bob := auth.WithSubject(ctx, "bob")
+	calendar := New(memorystorage.New(), config.CalendarConfig{OverlapPolicy: config.OverlapPolicyAllow})
+
+	startsAt := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(time.Hour)
+
+	t.Run("default calendar is created lazily", func(t *testing.T) {
+		calendars, err := calendar.ListCalendars(alice, "alice")
+		require.NoError(t, err)
+		require.Len(t, calendars, 1)
+		require.True(t, calendars[0].IsDefault)
+		require.Equal(t, defaultCalendarName, calendars[0].Name)
+
+		calendars, err = calendar.ListCalendars(alice, "alice")
+		require.NoError(t, err)
+		require.Len(t, calendars, 1)
+	})
+
+	t.Run("invalid calendars", func(t *testing.T) {
+		_, err := calendar.CreateCalendar(alice, "alice", " ", "", 0)
+		require.ErrorIs(t, err, app.ErrInvalidCalendar)
+
+		_, err = calendar.CreateCalendar(alice, "alice", "Work", "blue", 0)
+		require.ErrorIs(t, err, app.ErrInvalidCalendar)
+
+		_, err = calendar.CreateCalendar(bob, "alice", "Work", "", 0)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+	})
+
+	work, err := calendar.CreateCalendar(alice, "alice", "Work", "#1E90FF", 15*time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, calendar.CreateEvent(alice, "Personal", "", "alice", "", &startsAt, &endsAt, 0, "", nil, false))
+	require.NoError(t, calendar.CreateEvent(alice, "Standup", "", "alice", work.ID, &startsAt, &endsAt, 0, "", nil, false))
+
+	t.Run("events use calendar defaults", func(t *testing.T) {
+		events, err := calendar.ReadDailyEvents(alice, "alice", startsAt, "", []string{work.ID})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "Standup", events[0].Title)
+		require.Equal(t, work.ID, events[0].CalendarID)
+		require.Equal(t, 15*time.Minute, events[0].NotifyInterval)
+
+		events, err = calendar.ReadDailyEvents(alice, "alice", startsAt, "", nil)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+	})
+
+	t.Run("foreign calendar", func(t *testing.T) {
+		err := calendar.CreateEvent(bob, "Lunch", "", "bob", work.ID, &startsAt, &endsAt, 0, "", nil, false)
+		require.ErrorIs(t, err, app.ErrCalendarNotFound)
+
+		err = calendar.UpdateCalendar(bob, "bob", work.ID, "Mine", "", 0)
+		require.ErrorIs(t, err, app.ErrCalendarNotFound)
+	})
+
+	t.Run("delete calendar", func(t *testing.T) {
+		calendars, err := calendar.ListCalendars(alice, "alice")
+		require.NoError(t, err)
+		require.Len(t, calendars, 2)
+		require.Equal(t, work.ID, calendars[1].ID)
+
+		err = calendar.DeleteCalendar(alice, "alice", calendars[0].ID)
+		require.ErrorIs(t, err, app.ErrInvalidCalendar)
+
+		require.NoError(t, calendar.DeleteCalendar(alice, "alice", work.ID))
+
+		events, err := calendar.ReadDailyEvents(alice, "alice", startsAt, "", nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "Personal", events[0].Title)
+	})
+}
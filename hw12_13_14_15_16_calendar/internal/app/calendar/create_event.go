@@ -4,30 +4,68 @@ import (
 	"context"
 	"time"
 
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
 	"github.com/pkg/errors"
 )
 
-// CreateEvent метод регистрации события.
+// CreateEvent метод регистрации события в календаре calendarID пользователя userID. Пустой calendarID
+// означает календарь по умолчанию, нулевой notifyInterval - напоминание по умолчанию этого календаря.
 func (a *App) CreateEvent(
 	ctx context.Context,
 	title string,
 	description string,
 	userID string,
+	calendarID string,
 	startsAt *time.Time,
 	endAt *time.Time,
 	notifyInterval time.Duration,
+	rrule string,
+	exDates []time.Time,
+	tentative bool,
 ) error {
+	if err := a.authorize(ctx, userID, userID, storage.PermissionWrite); err != nil {
+		return errors.Wrap(err, "[app::CreateEvent]")
+	}
+
+	calendar, err := a.eventCalendar(ctx, userID, calendarID)
+	if err != nil {
+		return errors.Wrap(err, "[app::CreateEvent]")
+	}
+
+	if notifyInterval == 0 {
+		notifyInterval = calendar.DefaultNotifyInterval
+	}
+
 	event := storage.Event{
 		Title:          title,
 		StartsAt:       startsAt,
 		EndsAt:         endAt,
 		Description:    description,
 		UserID:         userID,
+		CalendarID:     calendar.ID,
 		NotifyInterval: notifyInterval,
+		RRule:          rrule,
+		ExDates:        exDates,
+		Tentative:      tentative,
+	}
+
+	return a.createEvent(ctx, &event)
+}
+
+// createEvent проверяет и сохраняет подготовленное событие.
+func (a *App) createEvent(ctx context.Context, event *storage.Event) error {
+	if err := validateRecurrence(event); err != nil {
+		return errors.Wrap(err, "[app::CreateEvent]: invalid recurrence")
+	}
+
+	event.Busy = a.isBusy(event.Tentative)
+
+	if err := a.repo.CreateEvent(ctx, event); err != nil {
+		return errors.Wrap(err, "[app::CreateEvent]: failed to create event")
 	}
 
-	err := a.repo.CreateEvent(ctx, &event)
+	a.notifyChange(ctx, app.ChangeTypeCreated, event)
 
-	return errors.Wrap(err, "[app::CreateEvent]: failed to create event")
+	return nil
 }
@@ -3,11 +3,23 @@ package calendar
 import (
 	"context"
 
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
 	"github.com/pkg/errors"
 )
 
-// DeleteEvent метод удаления события.
-func (a *App) DeleteEvent(ctx context.Context, eventID string) error {
-	err := a.repo.DeleteEvent(ctx, eventID)
-	return errors.Wrapf(err, "[app::DeleteEvent]: failed to delete event by ID %q", eventID)
+// DeleteEvent метод удаления события пользователем userID: владельцем или получившим доступ
+// на запись к его календарю.
+func (a *App) DeleteEvent(ctx context.Context, userID, eventID string) error {
+	event, err := a.authorizeChange(ctx, userID, eventID)
+	if err != nil {
+		return errors.Wrap(err, "[app::DeleteEvent]")
+	}
+
+	if err = a.repo.DeleteEvent(ctx, eventID); err != nil {
+		return errors.Wrapf(err, "[app::DeleteEvent]: failed to delete event by ID %q", eventID)
+	}
+
+	a.notifyChange(ctx, app.ChangeTypeDeleted, event)
+
+	return nil
 }
@@ -0,0 +1,52 @@
+package calendar
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/pkg/errors"
+)
+
+// CreateFeedToken метод выпуска секретного токена для подписки на iCalendar-ленту пользователя.
+// Токен содержит HMAC-SHA256 подпись и ID пользователя, поэтому не требует хранения.
+func (a *App) CreateFeedToken(ctx context.Context, userID string) (string, error) {
+	if err := authorizeOwner(ctx, userID); err != nil {
+		return "", errors.Wrap(err, "[app::CreateFeedToken]")
+	}
+
+	if a.cfg.FeedSecret == "" {
+		return "", errors.Wrap(app.ErrFeedsDisabled, "[app::CreateFeedToken]")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(append(a.signFeed(userID), userID...)), nil
+}
+
+// ResolveFeedToken метод проверки токена iCalendar-ленты, возвращает ID пользователя.
+func (a *App) ResolveFeedToken(_ context.Context, token string) (string, error) {
+	if a.cfg.FeedSecret == "" {
+		return "", errors.Wrap(app.ErrFeedsDisabled, "[app::ResolveFeedToken]")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) <= sha256.Size {
+		return "", errors.Wrap(app.ErrInvalidFeedToken, "[app::ResolveFeedToken]: malformed token")
+	}
+
+	signature, userID := data[:sha256.Size], string(data[sha256.Size:])
+
+	if !hmac.Equal(signature, a.signFeed(userID)) {
+		return "", errors.Wrap(app.ErrInvalidFeedToken, "[app::ResolveFeedToken]: signature mismatch")
+	}
+
+	return userID, nil
+}
+
+func (a *App) signFeed(userID string) []byte {
+	mac := hmac.New(sha256.New, []byte(a.cfg.FeedSecret))
+	mac.Write([]byte("feed:" + userID))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,57 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// GrantAccess метод выдачи пользователю granteeID доступа permission к календарю ownerID.
+// Повторная выдача заменяет прежний уровень доступа.
+func (a *App) GrantAccess(ctx context.Context, ownerID, granteeID string, permission storage.Permission) error {
+	if err := authorizeOwner(ctx, ownerID); err != nil {
+		return errors.Wrap(err, "[app::GrantAccess]")
+	}
+
+	if !storage.IsValidPermission(permission) {
+		return errors.Wrapf(app.ErrInvalidGrant, "[app::GrantAccess]: unknown permission %q", permission)
+	}
+
+	if granteeID == "" || granteeID == ownerID {
+		return errors.Wrapf(app.ErrInvalidGrant, "[app::GrantAccess]: invalid grantee %q", granteeID)
+	}
+
+	grant := storage.Grant{
+		OwnerID:    ownerID,
+		GranteeID:  granteeID,
+		Permission: permission,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	return errors.Wrapf(a.repo.SaveGrant(ctx, &grant),
+		"[app::GrantAccess]: failed to grant access to calendar of user %q", ownerID)
+}
+
+// RevokeAccess метод отзыва доступа пользователя granteeID к календарю ownerID.
+func (a *App) RevokeAccess(ctx context.Context, ownerID, granteeID string) error {
+	if err := authorizeOwner(ctx, ownerID); err != nil {
+		return errors.Wrap(err, "[app::RevokeAccess]")
+	}
+
+	return errors.Wrapf(a.repo.DeleteGrant(ctx, ownerID, granteeID),
+		"[app::RevokeAccess]: failed to revoke access to calendar of user %q", ownerID)
+}
+
+// ListGrants метод получения выданных разрешений на календарь ownerID.
+func (a *App) ListGrants(ctx context.Context, ownerID string) ([]*storage.Grant, error) {
+	if err := authorizeOwner(ctx, ownerID); err != nil {
+		return nil, errors.Wrap(err, "[app::ListGrants]")
+	}
+
+	grants, err := a.repo.ListGrants(ctx, ownerID)
+
+	return grants, errors.Wrapf(err, "[app::ListGrants]: failed to list grants of user %q", ownerID)
+}
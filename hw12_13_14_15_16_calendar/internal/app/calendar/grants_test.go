@@ -0,0 +1,101 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrants(t *testing.T) {
+	ctx := context.Background()
+	alice := auth.WithSubject(ctx, "alice")
+	bob := auth.WithSubject(ctx, "bob")
+	calendar := New(memorystorage.New(), config.CalendarConfig{OverlapPolicy: config.OverlapPolicyAllow})
+
+	startsAt := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(time.Hour)
+
+	require.NoError(t, calendar.CreateEvent(alice, "Standup", "", "alice", "", &startsAt, &endsAt, 0, "", nil, false))
+
+	events, err := calendar.ReadDailyEvents(alice, "alice", startsAt, "", nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	eventID := events[0].ID
+
+	t.Run("no grant", func(t *testing.T) {
+		_, err := calendar.QueryFreeBusy(bob, []string{"alice"}, startsAt, endsAt)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+
+		_, err = calendar.ReadDailyEvents(bob, "alice", startsAt, "", nil)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+	})
+
+	t.Run("free-busy", func(t *testing.T) {
+		require.NoError(t, calendar.GrantAccess(alice, "alice", "bob", storage.PermissionFreeBusy))
+
+		freeBusy, err := calendar.QueryFreeBusy(bob, []string{"alice"}, startsAt, endsAt)
+		require.NoError(t, err)
+		require.Len(t, freeBusy[0].Busy, 1)
+
+		_, err = calendar.ReadDailyEvents(bob, "alice", startsAt, "", nil)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+	})
+
+	t.Run("read", func(t *testing.T) {
+		require.NoError(t, calendar.GrantAccess(alice, "alice", "bob", storage.PermissionRead))
+
+		events, err := calendar.ReadDailyEvents(bob, "alice", startsAt, "", nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		err = calendar.UpdateEvent(bob, eventID, "Retro", "", "alice", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+
+		err = calendar.CreateEvent(bob, "Lunch", "", "alice", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+	})
+
+	t.Run("write", func(t *testing.T) {
+		require.NoError(t, calendar.GrantAccess(alice, "alice", "bob", storage.PermissionWrite))
+
+		err := calendar.UpdateEvent(bob, eventID, "Retro", "", "bob", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.NoError(t, err)
+
+		events, err := calendar.ReadDailyEvents(alice, "alice", startsAt, "", nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "Retro", events[0].Title)
+		require.Equal(t, "alice", events[0].UserID)
+	})
+
+	t.Run("only owner manages grants", func(t *testing.T) {
+		err := calendar.GrantAccess(bob, "alice", "carol", storage.PermissionRead)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+
+		_, err = calendar.ListGrants(bob, "alice")
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+
+		require.ErrorIs(t, calendar.GrantAccess(alice, "alice", "alice", storage.PermissionRead), app.ErrInvalidGrant)
+		require.ErrorIs(t, calendar.GrantAccess(alice, "alice", "carol", "admin"), app.ErrInvalidGrant)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		grants, err := calendar.ListGrants(alice, "alice")
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		require.Equal(t, storage.PermissionWrite, grants[0].Permission)
+
+		require.NoError(t, calendar.RevokeAccess(alice, "alice", "bob"))
+		require.ErrorIs(t, calendar.RevokeAccess(alice, "alice", "bob"), app.ErrGrantNotFound)
+
+		require.ErrorIs(t, calendar.DeleteEvent(bob, "alice", eventID), app.ErrPermissionDenied)
+	})
+}
@@ -0,0 +1,78 @@
+package calendar
+
+import (
+	"context"
+	"io"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/ical"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// ImportEvents метод импорта событий из iCalendar файла в календарь calendarID пользователя
+// (пустой calendarID - календарь по умолчанию).
+// События с уже импортированным UID пропускаются, ошибки отдельных событий не прерывают импорт.
+func (a *App) ImportEvents(
+	ctx context.Context,
+	userID, calendarID string,
+	data io.Reader,
+) ([]*app.ImportResult, error) {
+	if err := a.authorize(ctx, userID, userID, storage.PermissionWrite); err != nil {
+		return nil, errors.Wrap(err, "[app::ImportEvents]")
+	}
+
+	calendar, err := a.eventCalendar(ctx, userID, calendarID)
+	if err != nil {
+		return nil, errors.Wrap(err, "[app::ImportEvents]")
+	}
+
+	cal, eventErrs, err := ical.Decode(data)
+	if err != nil {
+		return nil, errors.Wrapf(app.ErrInvalidICalendar, "[app::ImportEvents]: failed to parse calendar: %v", err)
+	}
+
+	results := make([]*app.ImportResult, 0, len(eventErrs)+len(cal.Events))
+
+	for _, eventErr := range eventErrs {
+		results = append(results, &app.ImportResult{
+			UID:    eventErr.UID,
+			Status: app.ImportStatusFailed,
+			Err:    eventErr,
+		})
+	}
+
+	for _, icalEvent := range cal.Events {
+		notifyInterval := icalEvent.Alarm
+		if notifyInterval == 0 {
+			notifyInterval = calendar.DefaultNotifyInterval
+		}
+
+		event := storage.Event{
+			Title:          icalEvent.Summary,
+			StartsAt:       &icalEvent.Start,
+			EndsAt:         &icalEvent.End,
+			Description:    icalEvent.Description,
+			UserID:         userID,
+			CalendarID:     calendar.ID,
+			NotifyInterval: notifyInterval,
+			RRule:          icalEvent.RRule,
+			ExDates:        icalEvent.ExDates,
+			ICalUID:        icalEvent.UID,
+		}
+
+		result := app.ImportResult{UID: icalEvent.UID, Status: app.ImportStatusCreated}
+
+		if err = a.createEvent(ctx, &event); err != nil {
+			result.Status = app.ImportStatusFailed
+			if errors.Is(err, storage.ErrEventExists) {
+				result.Status = app.ImportStatusSkipped
+			}
+			result.Err = errors.Wrap(err, "[app::ImportEvents]")
+		}
+
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,72 @@
+package calendar
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportEventsErrors(t *testing.T) {
+	alice := auth.WithSubject(context.Background(), "alice")
+	calendar := New(memorystorage.New(), config.CalendarConfig{OverlapPolicy: config.OverlapPolicyAllow})
+
+	t.Run("malformed data", func(t *testing.T) {
+		_, err := calendar.ImportEvents(alice, "alice", "", strings.NewReader("BEGIN:VEVENT\r\n"))
+		require.ErrorIs(t, err, app.ErrInvalidICalendar)
+	})
+
+	t.Run("unknown calendar is not a parse error", func(t *testing.T) {
+		data := "BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"
+
+		_, err := calendar.ImportEvents(alice, "alice", "missing", strings.NewReader(data))
+		require.ErrorIs(t, err, app.ErrCalendarNotFound)
+		require.NotErrorIs(t, err, app.ErrInvalidICalendar)
+	})
+}
+
+func TestImportEventsAfterUpdate(t *testing.T) {
+	alice := auth.WithSubject(context.Background(), "alice")
+	calendar := New(memorystorage.New(), config.CalendarConfig{OverlapPolicy: config.OverlapPolicyAllow})
+
+	data := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:planning@example.com\r\n" +
+		"DTSTART:20261019T100000Z\r\n" +
+		"DTEND:20261019T110000Z\r\n" +
+		"SUMMARY:Planning\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	results, err := calendar.ImportEvents(alice, "alice", "", strings.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, app.ImportStatusCreated, results[0].Status)
+
+	startsAt := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	events, err := calendar.ReadDailyEvents(alice, "alice", startsAt, "", nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	event := events[0]
+	err = calendar.UpdateEvent(alice, event.ID, "Renamed planning", event.Description, "alice", "",
+		event.StartsAt, event.EndsAt, event.NotifyInterval, event.RRule, event.ExDates, event.Tentative)
+	require.NoError(t, err)
+
+	results, err = calendar.ImportEvents(alice, "alice", "", strings.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, app.ImportStatusSkipped, results[0].Status)
+
+	events, err = calendar.ReadDailyEvents(alice, "alice", startsAt, "", nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "planning@example.com", events[0].ICalUID)
+}
@@ -0,0 +1,101 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// InviteAttendees метод приглашения пользователей attendeeIDs на событие eventID с ролью role.
+// Приглашать может владелец события или пользователь с доступом на запись к его календарю.
+// Повторное приглашение меняет только роль участника, его ответ сохраняется.
+// Изменение события с новым составом участников публикуется подписчикам WatchEvents.
+func (a *App) InviteAttendees(
+	ctx context.Context,
+	userID, eventID string,
+	attendeeIDs []string,
+	role storage.AttendeeRole,
+) error {
+	if !storage.IsValidRole(role) {
+		return errors.Wrapf(app.ErrInvalidInvitation, "[app::InviteAttendees]: unknown role %q", role)
+	}
+
+	if len(attendeeIDs) == 0 {
+		return errors.Wrap(app.ErrInvalidInvitation, "[app::InviteAttendees]: no attendees")
+	}
+
+	event, err := a.authorizeChange(ctx, userID, eventID)
+	if err != nil {
+		return errors.Wrap(err, "[app::InviteAttendees]")
+	}
+
+	for _, attendeeID := range attendeeIDs {
+		if attendeeID == "" || attendeeID == event.UserID {
+			return errors.Wrapf(app.ErrInvalidInvitation, "[app::InviteAttendees]: invalid attendee %q", attendeeID)
+		}
+	}
+
+	for _, attendeeID := range attendeeIDs {
+		attendee := storage.Attendee{
+			EventID: eventID,
+			UserID:  attendeeID,
+			Role:    role,
+			Status:  storage.RSVPStatusNeedsAction,
+		}
+
+		if err = a.repo.SaveAttendee(ctx, &attendee); err != nil {
+			return errors.Wrapf(err, "[app::InviteAttendees]: failed to invite user %q", attendeeID)
+		}
+	}
+
+	a.notifyAttendeesChange(ctx, eventID)
+
+	return nil
+}
+
+// RespondToInvitation метод ответа пользователя userID на приглашение на событие eventID.
+// Принятые события попадают в выборки событий пользователя за день, неделю и месяц.
+// Изменение события с новым ответом участника публикуется подписчикам WatchEvents.
+func (a *App) RespondToInvitation(ctx context.Context, userID, eventID string, status storage.RSVPStatus) error {
+	if err := authorizeOwner(ctx, userID); err != nil {
+		return errors.Wrap(err, "[app::RespondToInvitation]")
+	}
+
+	if !storage.IsValidResponse(status) {
+		return errors.Wrapf(app.ErrInvalidInvitation, "[app::RespondToInvitation]: unknown response %q", status)
+	}
+
+	if err := a.repo.SetAttendeeStatus(ctx, eventID, userID, status); err != nil {
+		return errors.Wrapf(err, "[app::RespondToInvitation]: failed to respond to invitation to event %q", eventID)
+	}
+
+	a.notifyAttendeesChange(ctx, eventID)
+
+	return nil
+}
+
+// notifyAttendeesChange публикует обновление события eventID после изменения его участников.
+// Событие перечитывается, чтобы изменение несло актуальный состав участников и их ответы.
+func (a *App) notifyAttendeesChange(ctx context.Context, eventID string) {
+	event, err := a.repo.ReadEvent(ctx, eventID)
+	if err != nil {
+		log.Warn().Err(err).Str("event_id", eventID).Msg("[app::WatchEvents]: failed to read changed event")
+		return
+	}
+
+	a.notifyChange(ctx, app.ChangeTypeUpdated, event)
+}
+
+// ListInvitations метод получения событий, на которые приглашен пользователь, вместе с участниками.
+func (a *App) ListInvitations(ctx context.Context, userID string) ([]*storage.Event, error) {
+	if err := a.authorize(ctx, userID, userID, storage.PermissionRead); err != nil {
+		return nil, errors.Wrap(err, "[app::ListInvitations]")
+	}
+
+	events, err := a.repo.ListInvitations(ctx, userID)
+
+	return events, errors.Wrapf(err, "[app::ListInvitations]: failed to list invitations of user %q", userID)
+}
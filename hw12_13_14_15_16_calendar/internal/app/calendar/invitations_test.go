@@ -0,0 +1,95 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvitations(t *testing.T) {
+	ctx := context.Background()
+	alice := auth.WithSubject(ctx, "alice")
+	bob := auth.WithSubject(ctx, "bob")
+	calendar := New(memorystorage.New(), config.CalendarConfig{OverlapPolicy: config.OverlapPolicyAllow})
+
+	startsAt := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(time.Hour)
+
+	require.NoError(t, calendar.CreateEvent(alice, "Planning", "", "alice", "", &startsAt, &endsAt, 0, "", nil, false))
+
+	events, err := calendar.ReadDailyEvents(alice, "alice", startsAt, "", nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	eventID := events[0].ID
+
+	t.Run("invalid invitations", func(t *testing.T) {
+		err := calendar.InviteAttendees(bob, "bob", eventID, []string{"carol"}, storage.AttendeeRoleRequired)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+
+		err = calendar.InviteAttendees(alice, "alice", eventID, []string{"alice"}, storage.AttendeeRoleRequired)
+		require.ErrorIs(t, err, app.ErrInvalidInvitation)
+
+		err = calendar.InviteAttendees(alice, "alice", eventID, []string{"bob"}, "chair")
+		require.ErrorIs(t, err, app.ErrInvalidInvitation)
+
+		err = calendar.RespondToInvitation(bob, "bob", eventID, storage.RSVPStatusAccepted)
+		require.ErrorIs(t, err, app.ErrInvitationNotFound)
+	})
+
+	t.Run("accepted invitation appears in attendee reads", func(t *testing.T) {
+		err := calendar.InviteAttendees(alice, "alice", eventID, []string{"bob"}, storage.AttendeeRoleOptional)
+		require.NoError(t, err)
+
+		invitations, err := calendar.ListInvitations(bob, "bob")
+		require.NoError(t, err)
+		require.Len(t, invitations, 1)
+		require.Equal(t, storage.RSVPStatusNeedsAction, invitations[0].Attendees[0].Status)
+
+		events, err := calendar.ReadDailyEvents(bob, "bob", startsAt, "", nil)
+		require.NoError(t, err)
+		require.Empty(t, events)
+
+		err = calendar.RespondToInvitation(alice, "bob", eventID, storage.RSVPStatusAccepted)
+		require.ErrorIs(t, err, app.ErrPermissionDenied)
+
+		err = calendar.RespondToInvitation(bob, "bob", eventID, storage.RSVPStatusNeedsAction)
+		require.ErrorIs(t, err, app.ErrInvalidInvitation)
+
+		require.NoError(t, calendar.RespondToInvitation(bob, "bob", eventID, storage.RSVPStatusAccepted))
+
+		events, err = calendar.ReadDailyEvents(bob, "bob", startsAt, "", nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "Planning", events[0].Title)
+	})
+
+	t.Run("reinvite keeps response", func(t *testing.T) {
+		err := calendar.InviteAttendees(alice, "alice", eventID, []string{"bob"}, storage.AttendeeRoleRequired)
+		require.NoError(t, err)
+
+		invitations, err := calendar.ListInvitations(bob, "bob")
+		require.NoError(t, err)
+		require.Equal(t, storage.AttendeeRoleRequired, invitations[0].Attendees[0].Role)
+		require.Equal(t, storage.RSVPStatusAccepted, invitations[0].Attendees[0].Status)
+	})
+	t.Run("accepted invitation counts as busy time", func(t *testing.T) {
+		freeBusy, err := calendar.QueryFreeBusy(bob, []string{"bob"}, startsAt.Add(-time.Hour), endsAt.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, freeBusy, 1)
+		require.Equal(t, []app.BusyInterval{{Start: startsAt, End: endsAt}}, freeBusy[0].Busy)
+
+		require.NoError(t, calendar.RespondToInvitation(bob, "bob", eventID, storage.RSVPStatusDeclined))
+
+		freeBusy, err = calendar.QueryFreeBusy(bob, []string{"bob"}, startsAt.Add(-time.Hour), endsAt.Add(time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, freeBusy[0].Busy)
+	})
+}
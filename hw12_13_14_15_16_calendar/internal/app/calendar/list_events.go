@@ -0,0 +1,112 @@
+package calendar
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// Ограничения размера страницы ListEvents.
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// pageToken содержимое токена страницы: курсор последнего события и порядок выборки,
+// для которого курсор действителен.
+type pageToken struct {
+	StartsAt time.Time         `json:"s"`
+	ID       string            `json:"i"`
+	Order    storage.SortOrder `json:"o"`
+}
+
+// ListEvents метод постраничного чтения событий пользователя с фильтрами. Страницы продолжаются
+// по курсору (начало, ID) последнего события, поэтому изменения событий между запросами
+// не приводят к пропускам и повторам на следующих страницах.
+func (a *App) ListEvents(ctx context.Context, params *app.ListEventsParams) (*app.EventsPage, error) {
+	if !params.From.IsZero() && !params.To.IsZero() && !params.From.Before(params.To) {
+		return nil, errors.Wrapf(app.ErrInvalidRange, "[app::ListEvents]: [%v, %v)", params.From, params.To)
+	}
+
+	if err := a.authorize(ctx, params.UserID, params.UserID, storage.PermissionRead); err != nil {
+		return nil, errors.Wrap(err, "[app::ListEvents]")
+	}
+
+	order := params.Order
+	switch order {
+	case "":
+		order = storage.SortOrderAsc
+	case storage.SortOrderAsc, storage.SortOrderDesc:
+	default:
+		return nil, errors.Wrapf(app.ErrInvalidOrder, "[app::ListEvents]: %q", order)
+	}
+
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	query := &storage.ListEventsQuery{
+		UserID:      params.UserID,
+		CalendarIDs: params.CalendarIDs,
+		From:        params.From,
+		To:          params.To,
+		Query:       params.Query,
+		Order:       order,
+		Limit:       pageSize + 1,
+	}
+
+	if params.PageToken != "" {
+		cursor, err := decodePageToken(params.PageToken, order)
+		if err != nil {
+			return nil, errors.Wrap(err, "[app::ListEvents]")
+		}
+
+		query.After = cursor
+	}
+
+	events, err := a.repo.ListEvents(ctx, query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[app::ListEvents]: failed to list events by user %q", params.UserID)
+	}
+
+	page := &app.EventsPage{Events: events}
+
+	if len(events) > pageSize {
+		page.Events = events[:pageSize]
+		page.NextPageToken = encodePageToken(page.Events[pageSize-1].Cursor(), order)
+	}
+
+	return page, nil
+}
+
+func encodePageToken(cursor storage.EventCursor, order storage.SortOrder) string {
+	// маршалинг структуры из строк и времени не возвращает ошибок
+	data, _ := json.Marshal(pageToken{StartsAt: cursor.StartsAt, ID: cursor.ID, Order: order})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(token string, order storage.SortOrder) (*storage.EventCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(app.ErrInvalidPageToken, "malformed token")
+	}
+
+	var decoded pageToken
+	if err = json.Unmarshal(data, &decoded); err != nil || decoded.ID == "" {
+		return nil, errors.Wrap(app.ErrInvalidPageToken, "malformed token")
+	}
+
+	if decoded.Order != order {
+		return nil, errors.Wrapf(app.ErrInvalidPageToken, "token was issued for order %q", decoded.Order)
+	}
+
+	return &storage.EventCursor{StartsAt: decoded.StartsAt, ID: decoded.ID}, nil
+}
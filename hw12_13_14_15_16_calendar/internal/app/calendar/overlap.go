@@ -0,0 +1,15 @@
+package calendar
+
+import "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+
+// isBusy определяет по политике пересечений, занимает ли событие время пользователя.
+func (a *App) isBusy(tentative bool) bool {
+	switch a.cfg.OverlapPolicy {
+	case config.OverlapPolicyReject:
+		return true
+	case config.OverlapPolicyAllowTentative:
+		return !tentative
+	default:
+		return false
+	}
+}
@@ -0,0 +1,117 @@
+package calendar
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// maxFreeBusyRange максимальная длина интервала запроса занятости.
+const maxFreeBusyRange = 366 * 24 * time.Hour
+
+// QueryFreeBusy метод получения объединенных интервалов занятости пользователей за [from, to).
+// Пользователь занят во время собственных событий и событий, приглашение на которые он принял.
+// Предварительные (tentative) события время не занимают.
+func (a *App) QueryFreeBusy(ctx context.Context, userIDs []string, from, to time.Time) ([]*app.FreeBusy, error) {
+	if len(userIDs) == 0 {
+		return nil, errors.Wrap(app.ErrInvalidRange, "[app::QueryFreeBusy]: no users requested")
+	}
+
+	if !from.Before(to) || to.Sub(from) > maxFreeBusyRange {
+		return nil, errors.Wrapf(app.ErrInvalidRange, "[app::QueryFreeBusy]: [%v, %v)", from, to)
+	}
+
+	for _, userID := range userIDs {
+		if err := a.authorize(ctx, userID, userID, storage.PermissionFreeBusy); err != nil {
+			return nil, errors.Wrap(err, "[app::QueryFreeBusy]")
+		}
+	}
+
+	events, err := a.repo.ReadEventsInRange(ctx, userIDs, from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "[app::QueryFreeBusy]: failed to read events")
+	}
+
+	busyByUser := make(map[string][]app.BusyInterval, len(userIDs))
+	for _, event := range events {
+		if event.Tentative {
+			continue
+		}
+
+		interval := app.BusyInterval{
+			Start: maxTime(*event.StartsAt, from),
+			End:   minTime(*event.EndsAt, to),
+		}
+
+		for _, userID := range busyUsers(event) {
+			busyByUser[userID] = append(busyByUser[userID], interval)
+		}
+	}
+
+	result := make([]*app.FreeBusy, 0, len(userIDs))
+	for _, userID := range userIDs {
+		result = append(result, &app.FreeBusy{
+			UserID: userID,
+			Busy:   mergeIntervals(busyByUser[userID]),
+		})
+	}
+
+	return result, nil
+}
+
+// busyUsers возвращает пользователей, которых событие делает занятыми: владельца и участников,
+// принявших приглашение.
+func busyUsers(event *storage.Event) []string {
+	users := []string{event.UserID}
+
+	for _, attendee := range event.Attendees {
+		if attendee.Status == storage.RSVPStatusAccepted {
+			users = append(users, attendee.UserID)
+		}
+	}
+
+	return users
+}
+
+// mergeIntervals объединяет пересекающиеся и смежные интервалы.
+func mergeIntervals(intervals []app.BusyInterval) []app.BusyInterval {
+	slices.SortFunc(intervals, func(a, b app.BusyInterval) int {
+		return a.Start.Compare(b.Start)
+	})
+
+	var merged []app.BusyInterval
+	for _, interval := range intervals {
+		if !interval.Start.Before(interval.End) {
+			continue
+		}
+
+		if last := len(merged) - 1; last >= 0 && !interval.Start.After(merged[last].End) {
+			merged[last].End = maxTime(merged[last].End, interval.End)
+			continue
+		}
+
+		merged = append(merged, interval)
+	}
+
+	return merged
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
@@ -9,8 +9,25 @@ import (
 )
 
 // ReadDailyEvents метод получения событий за определенную дату.
-func (a *App) ReadDailyEvents(ctx context.Context, userID string, date time.Time) ([]*storage.Event, error) {
-	events, err := a.repo.ReadDailyEvents(ctx, userID, date)
+// Границы периода вычисляются в часовом поясе timeZone, если он задан, иначе в поясе пользователя.
+// Непустой calendarIDs ограничивает выборку событиями указанных календарей.
+func (a *App) ReadDailyEvents(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+	timeZone string,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
+	if err := a.authorize(ctx, userID, userID, storage.PermissionRead); err != nil {
+		return nil, errors.Wrap(err, "[app::ReadDailyEvents]")
+	}
+
+	loc, err := a.location(ctx, userID, timeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "[app::ReadDailyEvents]")
+	}
+
+	events, err := a.repo.ReadDailyEvents(ctx, userID, date.In(loc), calendarIDs)
 
 	return events, errors.Wrapf(err,
 		"[app::ReadDailyEvents]: failed to get daily events by user %q and date %v", userID, date)
@@ -9,8 +9,25 @@ import (
 )
 
 // ReadMonthlyEvents метод получения событий за месяц, начиная с определенной даты.
-func (a *App) ReadMonthlyEvents(ctx context.Context, userID string, date time.Time) ([]*storage.Event, error) {
-	events, err := a.repo.ReadMonthlyEvents(ctx, userID, date)
+// Границы периода вычисляются в часовом поясе timeZone, если он задан, иначе в поясе пользователя.
+// Непустой calendarIDs ограничивает выборку событиями указанных календарей.
+func (a *App) ReadMonthlyEvents(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+	timeZone string,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
+	if err := a.authorize(ctx, userID, userID, storage.PermissionRead); err != nil {
+		return nil, errors.Wrap(err, "[app::ReadMonthlyEvents]")
+	}
+
+	loc, err := a.location(ctx, userID, timeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "[app::ReadMonthlyEvents]")
+	}
+
+	events, err := a.repo.ReadMonthlyEvents(ctx, userID, date.In(loc), calendarIDs)
 
 	return events, errors.Wrapf(err,
 		"[app::ReadMonthlyEvents]: failed to get weekly events by user %q and date %v", userID, date)
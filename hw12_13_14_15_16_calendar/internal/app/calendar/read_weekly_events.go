@@ -9,8 +9,25 @@ import (
 )
 
 // ReadWeeklyEvents метод получения событий за неделю, начиная с определенной даты.
-func (a *App) ReadWeeklyEvents(ctx context.Context, userID string, date time.Time) ([]*storage.Event, error) {
-	events, err := a.repo.ReadWeeklyEvents(ctx, userID, date)
+// Границы периода вычисляются в часовом поясе timeZone, если он задан, иначе в поясе пользователя.
+// Непустой calendarIDs ограничивает выборку событиями указанных календарей.
+func (a *App) ReadWeeklyEvents(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+	timeZone string,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
+	if err := a.authorize(ctx, userID, userID, storage.PermissionRead); err != nil {
+		return nil, errors.Wrap(err, "[app::ReadWeeklyEvents]")
+	}
+
+	loc, err := a.location(ctx, userID, timeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "[app::ReadWeeklyEvents]")
+	}
+
+	events, err := a.repo.ReadWeeklyEvents(ctx, userID, date.In(loc), calendarIDs)
 
 	return events, errors.Wrapf(err,
 		"[app::ReadWeeklyEvents]: failed to get weekly events by user %q and date %v", userID, date)
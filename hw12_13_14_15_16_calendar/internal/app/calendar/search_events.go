@@ -0,0 +1,34 @@
+package calendar
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// maxSearchResults максимальное количество событий в ответе поиска.
+const maxSearchResults = 100
+
+// SearchEvents метод полнотекстового поиска событий пользователя по названию и описанию
+// в календарях calendarIDs (во всех, если список пуст). Подходят события с любым из слов запроса;
+// возвращает до maxSearchResults наиболее релевантных событий.
+func (a *App) SearchEvents(
+	ctx context.Context,
+	userID, query string,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, errors.Wrap(app.ErrEmptyQuery, "[app::SearchEvents]")
+	}
+
+	if err := a.authorize(ctx, userID, userID, storage.PermissionRead); err != nil {
+		return nil, errors.Wrap(err, "[app::SearchEvents]")
+	}
+
+	events, err := a.repo.SearchEvents(ctx, userID, query, calendarIDs, maxSearchResults)
+
+	return events, errors.Wrapf(err, "[app::SearchEvents]: failed to search events by user %q", userID)
+}
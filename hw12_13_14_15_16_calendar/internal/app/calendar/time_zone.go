@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/pkg/errors"
+)
+
+// SetTimeZone метод сохранения IANA часового пояса пользователя, в котором вычисляются
+// границы дня, недели и месяца при чтении событий.
+func (a *App) SetTimeZone(ctx context.Context, userID, timeZone string) error {
+	if err := authorizeOwner(ctx, userID); err != nil {
+		return errors.Wrap(err, "[app::SetTimeZone]")
+	}
+
+	if _, err := loadLocation(timeZone); err != nil || timeZone == "" {
+		return errors.Wrapf(app.ErrInvalidTimeZone, "[app::SetTimeZone]: %q", timeZone)
+	}
+
+	return errors.Wrapf(a.repo.SetUserTimeZone(ctx, userID, timeZone),
+		"[app::SetTimeZone]: failed to set time zone of user %q", userID)
+}
+
+// location возвращает часовой пояс для вычисления границ периодов: override из запроса, если задан,
+// иначе сохраненный пояс пользователя, иначе пояс по умолчанию из конфига.
+func (a *App) location(ctx context.Context, userID, override string) (*time.Location, error) {
+	if override != "" {
+		loc, err := loadLocation(override)
+		if err != nil {
+			return nil, errors.Wrapf(app.ErrInvalidTimeZone, "%q", override)
+		}
+
+		return loc, nil
+	}
+
+	timeZone, err := a.repo.ReadUserTimeZone(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read user time zone")
+	}
+
+	if timeZone == "" {
+		timeZone = a.cfg.DefaultTimeZone
+	}
+
+	loc, err := loadLocation(timeZone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't load time zone %q", timeZone)
+	}
+
+	return loc, nil
+}
+
+// loadLocation загружает IANA часовой пояс. Пустое имя соответствует UTC, имя "Local" не допускается,
+// так как зависит от окружения сервера.
+func loadLocation(name string) (*time.Location, error) {
+	if name == "Local" {
+		return nil, errors.New("local time zone is not allowed")
+	}
+
+	loc, err := time.LoadLocation(name)
+
+	return loc, errors.Wrap(err, "can't load location")
+}
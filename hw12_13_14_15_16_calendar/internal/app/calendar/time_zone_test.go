@@ -0,0 +1,60 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeZones(t *testing.T) {
+	ctx := context.Background()
+	calendar := New(memorystorage.New(), config.CalendarConfig{OverlapPolicy: config.OverlapPolicyAllow})
+
+	create := func(userID string, startsAt time.Time) {
+		t.Helper()
+
+		endsAt := startsAt.Add(15 * time.Minute)
+		err := calendar.CreateEvent(ctx, "Event", "", userID, "", &startsAt, &endsAt, 0, "", nil, false)
+		require.NoError(t, err)
+	}
+
+	t.Run("day boundary in user time zone", func(t *testing.T) {
+		require.NoError(t, calendar.SetTimeZone(ctx, "moscow", "Europe/Moscow"))
+
+		// 00:30 19 октября по Москве
+		create("moscow", time.Date(2026, 10, 18, 21, 30, 0, 0, time.UTC))
+
+		date := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+		events, err := calendar.ReadDailyEvents(ctx, "moscow", date, "", nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		events, err = calendar.ReadDailyEvents(ctx, "moscow", date, "UTC", nil)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("day with DST change lasts 25 hours", func(t *testing.T) {
+		require.NoError(t, calendar.SetTimeZone(ctx, "new-york", "America/New_York"))
+
+		// 23:30 1 ноября по Нью-Йорку, после перехода на зимнее время
+		create("new-york", time.Date(2026, 11, 2, 4, 30, 0, 0, time.UTC))
+
+		events, err := calendar.ReadDailyEvents(ctx, "new-york", time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC), "", nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+	})
+
+	t.Run("invalid time zone rejected", func(t *testing.T) {
+		require.ErrorIs(t, calendar.SetTimeZone(ctx, "user", "Mars/Olympus"), app.ErrInvalidTimeZone)
+
+		_, err := calendar.ReadWeeklyEvents(ctx, "user", time.Now(), "Local", nil)
+		require.ErrorIs(t, err, app.ErrInvalidTimeZone)
+	})
+}
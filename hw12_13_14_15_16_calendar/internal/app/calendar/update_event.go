@@ -4,32 +4,67 @@ import (
 	"context"
 	"time"
 
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
 	"github.com/pkg/errors"
 )
 
-// UpdateEvent метод обновления события.
+// UpdateEvent метод обновления события пользователем userID: владельцем или получившим доступ
+// на запись к его календарю. Владелец события не меняется; непустой calendarID переносит событие
+// в другой календарь владельца.
 func (a *App) UpdateEvent(
 	ctx context.Context,
 	id string,
 	title string,
 	description string,
 	userID string,
+	calendarID string,
 	startsAt *time.Time,
 	endAt *time.Time,
 	notifyInterval time.Duration,
+	rrule string,
+	exDates []time.Time,
+	tentative bool,
 ) error {
+	existing, err := a.authorizeChange(ctx, userID, id)
+	if err != nil {
+		return errors.Wrap(err, "[app::UpdateEvent]")
+	}
+
+	if calendarID == "" {
+		calendarID = existing.CalendarID
+	} else if _, err = a.userCalendar(ctx, existing.UserID, calendarID); err != nil {
+		return errors.Wrap(err, "[app::UpdateEvent]")
+	}
+
 	event := storage.Event{
 		ID:             id,
 		Title:          title,
 		StartsAt:       startsAt,
 		EndsAt:         endAt,
 		Description:    description,
-		UserID:         userID,
+		UserID:         existing.UserID,
+		CalendarID:     calendarID,
 		NotifyInterval: notifyInterval,
+		RRule:          rrule,
+		ExDates:        exDates,
+		Tentative:      tentative,
+	}
+
+	if err = validateRecurrence(&event); err != nil {
+		return errors.Wrap(err, "[app::UpdateEvent]: invalid recurrence")
+	}
+
+	event.Busy = a.isBusy(event.Tentative)
+
+	if err = a.repo.UpdateEvent(ctx, &event); err != nil {
+		return errors.Wrapf(err, "[app::UpdateEvent]: failed to update event with ID %q", id)
 	}
 
-	err := a.repo.UpdateEvent(ctx, &event)
+	// участники не меняются при обновлении, но нужны подписчикам изменения
+	changed := event
+	changed.Attendees = existing.Attendees
+	a.notifyChange(ctx, app.ChangeTypeUpdated, &changed)
 
-	return errors.Wrapf(err, "[app::UpdateEvent]: failed to update event with ID %q", id)
+	return nil
 }
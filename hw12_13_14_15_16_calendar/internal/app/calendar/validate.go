@@ -0,0 +1,17 @@
+package calendar
+
+import (
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/rrule"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+)
+
+// validateRecurrence проверяет корректность правила повторения события.
+func validateRecurrence(event *storage.Event) error {
+	if !event.IsRecurring() {
+		return nil
+	}
+
+	_, err := rrule.Parse(event.RRule)
+
+	return err
+}
@@ -0,0 +1,137 @@
+package calendar
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// watchBuffer количество изменений, которые подписчик может не успеть прочитать;
+// при переполнении буфера подписчик отключается, чтобы не задерживать изменение событий.
+const watchBuffer = 64
+
+// WatchEvents подписывается на изменения событий, которые видит пользователь: его собственных,
+// событий календарей, к которым у него есть доступ на чтение, и событий, на которые он приглашен.
+// Канал закрывается при отмене ctx,
+// а также если подписчик не успевает читать изменения - в этом случае ему следует подписаться заново
+// и перечитать события.
+func (a *App) WatchEvents(ctx context.Context, userID string) (<-chan *app.EventChange, error) {
+	if err := a.authorize(ctx, userID, userID, storage.PermissionRead); err != nil {
+		return nil, errors.Wrap(err, "[app::WatchEvents]")
+	}
+
+	sub := a.hub.subscribe(userID)
+
+	go func() {
+		<-ctx.Done()
+		a.hub.unsubscribe(userID, sub)
+	}()
+
+	return sub, nil
+}
+
+// notifyChange рассылает изменение события его владельцу, пользователям с доступом на чтение
+// к календарю владельца и участникам события.
+func (a *App) notifyChange(ctx context.Context, changeType app.ChangeType, event *storage.Event) {
+	a.hub.publish(&app.EventChange{
+		Type:      changeType,
+		EventID:   event.ID,
+		UserID:    event.UserID,
+		Event:     event,
+		ChangedAt: time.Now().UTC(),
+	}, a.changeAudience(ctx, event))
+}
+
+// changeAudience возвращает пользователей, которые видят событие. Если разрешения прочитать
+// не удалось, изменение получат только владелец и участники.
+func (a *App) changeAudience(ctx context.Context, event *storage.Event) []string {
+	audience := []string{event.UserID}
+
+	for _, attendee := range event.Attendees {
+		audience = append(audience, attendee.UserID)
+	}
+
+	grants, err := a.repo.ListGrants(ctx, event.UserID)
+	if err != nil {
+		log.Warn().Err(err).Str("user_id", event.UserID).Msg("[app::WatchEvents]: failed to list grants")
+	}
+
+	for _, grant := range grants {
+		if grant.Allows(storage.PermissionRead) {
+			audience = append(audience, grant.GranteeID)
+		}
+	}
+
+	slices.Sort(audience)
+
+	return slices.Compact(audience)
+}
+
+// hub рассылает изменения событий подписчикам внутри процесса.
+type hub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan *app.EventChange]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subscribers: make(map[string]map[chan *app.EventChange]struct{})}
+}
+
+func (h *hub) subscribe(userID string) chan *app.EventChange {
+	sub := make(chan *app.EventChange, watchBuffer)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[chan *app.EventChange]struct{})
+	}
+	h.subscribers[userID][sub] = struct{}{}
+
+	return sub
+}
+
+func (h *hub) unsubscribe(userID string, sub chan *app.EventChange) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(userID, sub)
+}
+
+// remove удаляет подписчика и закрывает его канал, если он еще подписан. Вызывается под mu.
+func (h *hub) remove(userID string, sub chan *app.EventChange) {
+	subs := h.subscribers[userID]
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	close(sub)
+
+	if len(subs) == 0 {
+		delete(h.subscribers, userID)
+	}
+}
+
+// publish отправляет изменение подписчикам пользователей audience, не блокируясь на медленных подписчиках.
+func (h *hub) publish(change *app.EventChange, audience []string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, userID := range audience {
+		for sub := range h.subscribers[userID] {
+			select {
+			case sub <- change:
+			default:
+				log.Warn().Str("user_id", userID).Msg("[app::WatchEvents]: dropping slow watcher")
+				h.remove(userID, sub)
+			}
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package calendar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveChange(t *testing.T, changes <-chan *app.EventChange) *app.EventChange {
+	t.Helper()
+
+	select {
+	case change, ok := <-changes:
+		require.True(t, ok, "watch channel closed")
+		return change
+	case <-time.After(time.Second):
+		require.FailNow(t, "no change received")
+		return nil
+	}
+}
+
+func TestWatchEvents(t *testing.T) {
+	ctx := context.Background()
+	calendar := New(memorystorage.New(), config.CalendarConfig{OverlapPolicy: config.OverlapPolicyAllow})
+
+	startsAt := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(time.Hour)
+
+	t.Run("changes of watched user", func(t *testing.T) {
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		changes, err := calendar.WatchEvents(watchCtx, "user")
+		require.NoError(t, err)
+
+		others, err := calendar.WatchEvents(watchCtx, "other")
+		require.NoError(t, err)
+
+		err = calendar.CreateEvent(ctx, "Standup", "", "user", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.NoError(t, err)
+
+		created := receiveChange(t, changes)
+		require.Equal(t, app.ChangeTypeCreated, created.Type)
+		require.Equal(t, "Standup", created.Event.Title)
+		require.NotEmpty(t, created.EventID)
+
+		err = calendar.UpdateEvent(ctx, created.EventID, "Retro", "", "user", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.NoError(t, err)
+
+		updated := receiveChange(t, changes)
+		require.Equal(t, app.ChangeTypeUpdated, updated.Type)
+		require.Equal(t, created.EventID, updated.EventID)
+		require.Equal(t, "Retro", updated.Event.Title)
+
+		require.NoError(t, calendar.DeleteEvent(ctx, "user", created.EventID))
+
+		deleted := receiveChange(t, changes)
+		require.Equal(t, app.ChangeTypeDeleted, deleted.Type)
+		require.Equal(t, created.EventID, deleted.EventID)
+
+		require.Empty(t, others)
+
+		cancel()
+		_, ok := <-changes
+		require.False(t, ok)
+	})
+
+	t.Run("changes reach grantees and attendees", func(t *testing.T) {
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		require.NoError(t, calendar.GrantAccess(ctx, "owner", "reader", storage.PermissionRead))
+		require.NoError(t, calendar.GrantAccess(ctx, "owner", "busy-only", storage.PermissionFreeBusy))
+
+		reader, err := calendar.WatchEvents(watchCtx, "reader")
+		require.NoError(t, err)
+
+		busyOnly, err := calendar.WatchEvents(watchCtx, "busy-only")
+		require.NoError(t, err)
+
+		guest, err := calendar.WatchEvents(watchCtx, "guest")
+		require.NoError(t, err)
+
+		err = calendar.CreateEvent(ctx, "Planning", "", "owner", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.NoError(t, err)
+
+		created := receiveChange(t, reader)
+		require.Equal(t, app.ChangeTypeCreated, created.Type)
+		require.Equal(t, "owner", created.UserID)
+		require.Empty(t, guest)
+
+		err = calendar.InviteAttendees(ctx, "owner", created.EventID, []string{"guest"}, storage.AttendeeRoleRequired)
+		require.NoError(t, err)
+
+		invited := receiveChange(t, guest)
+		require.Equal(t, app.ChangeTypeUpdated, invited.Type)
+		require.Equal(t, storage.RSVPStatusNeedsAction, invited.Event.Attendees[0].Status)
+		require.Equal(t, app.ChangeTypeUpdated, receiveChange(t, reader).Type)
+
+		require.NoError(t, calendar.RespondToInvitation(ctx, "guest", created.EventID, storage.RSVPStatusAccepted))
+
+		responded := receiveChange(t, reader)
+		require.Equal(t, app.ChangeTypeUpdated, responded.Type)
+		require.Equal(t, storage.RSVPStatusAccepted, responded.Event.Attendees[0].Status)
+		require.Equal(t, app.ChangeTypeUpdated, receiveChange(t, guest).Type)
+
+		err = calendar.UpdateEvent(ctx, created.EventID, "Review", "", "owner", "", &startsAt, &endsAt, 0, "", nil, false)
+		require.NoError(t, err)
+
+		updated := receiveChange(t, guest)
+		require.Equal(t, app.ChangeTypeUpdated, updated.Type)
+		require.Len(t, updated.Event.Attendees, 1)
+		require.Equal(t, app.ChangeTypeUpdated, receiveChange(t, reader).Type)
+
+		require.NoError(t, calendar.DeleteEvent(ctx, "owner", created.EventID))
+
+		require.Equal(t, app.ChangeTypeDeleted, receiveChange(t, guest).Type)
+		require.Equal(t, app.ChangeTypeDeleted, receiveChange(t, reader).Type)
+		require.Empty(t, busyOnly)
+	})
+
+	t.Run("calendar deletion publishes deleted events", func(t *testing.T) {
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		changes, err := calendar.WatchEvents(watchCtx, "planner")
+		require.NoError(t, err)
+
+		work, err := calendar.CreateCalendar(ctx, "planner", "Work", "", 0)
+		require.NoError(t, err)
+
+		err = calendar.CreateEvent(ctx, "Sprint review", "", "planner", work.ID, &startsAt, &endsAt, 0, "", nil, false)
+		require.NoError(t, err)
+
+		created := receiveChange(t, changes)
+		require.Equal(t, app.ChangeTypeCreated, created.Type)
+
+		require.NoError(t, calendar.DeleteCalendar(ctx, "planner", work.ID))
+
+		deleted := receiveChange(t, changes)
+		require.Equal(t, app.ChangeTypeDeleted, deleted.Type)
+		require.Equal(t, created.EventID, deleted.EventID)
+		require.Equal(t, "Sprint review", deleted.Event.Title)
+	})
+
+	t.Run("slow watcher is dropped", func(t *testing.T) {
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		slow, err := calendar.WatchEvents(watchCtx, "slow")
+		require.NoError(t, err)
+
+		for range watchBuffer + 1 {
+			err = calendar.CreateEvent(ctx, "Event", "", "slow", "", &startsAt, &endsAt, 0, "", nil, false)
+			require.NoError(t, err)
+		}
+
+		received := 0
+		for range slow {
+			received++
+		}
+
+		require.Equal(t, watchBuffer, received)
+	})
+}
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// Бизнес-ошибки приложения календаря.
+var (
+	ErrFeedsDisabled      = errors.New("calendar feeds are disabled")
+	ErrInvalidFeedToken   = errors.New("invalid calendar feed token")
+	ErrDateBusy           = storage.ErrDateBusy
+	ErrInvalidRange       = errors.New("invalid time range")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+	ErrInvalidOrder       = errors.New("invalid sort order")
+	ErrEmptyQuery         = errors.New("empty search query")
+	ErrInvalidTimeZone    = errors.New("invalid time zone")
+	ErrEventNotFound      = storage.ErrEventNotFound
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrInvalidGrant       = errors.New("invalid grant")
+	ErrGrantNotFound      = storage.ErrGrantNotFound
+	ErrInvalidInvitation  = errors.New("invalid invitation")
+	ErrInvitationNotFound = storage.ErrAttendeeNotFound
+	ErrInvalidCalendar    = errors.New("invalid calendar")
+	ErrInvalidICalendar   = errors.New("invalid iCalendar data")
+	ErrCalendarNotFound   = storage.ErrCalendarNotFound
+)
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
@@ -9,10 +10,28 @@ import (
 
 // IApp основной API интерфейс.
 type IApp interface {
-	CreateEvent(ctx context.Context, title, description, userID string, startsAt, endAt *time.Time, notifyInterval time.Duration) error //nolint:lll
-	DeleteEvent(ctx context.Context, eventID string) error
-	ReadDailyEvents(ctx context.Context, userID string, date time.Time) ([]*storage.Event, error)
-	ReadWeeklyEvents(ctx context.Context, userID string, date time.Time) ([]*storage.Event, error)
-	ReadMonthlyEvents(ctx context.Context, userID string, date time.Time) ([]*storage.Event, error)
-	UpdateEvent(ctx context.Context, id, title, description, userID string, startsAt, endAt *time.Time, notifyInterval time.Duration) error //nolint:lll
+	CreateCalendar(ctx context.Context, userID, name, color string, defaultNotifyInterval time.Duration) (*storage.Calendar, error)                                                                    //nolint:lll
+	CreateEvent(ctx context.Context, title, description, userID, calendarID string, startsAt, endAt *time.Time, notifyInterval time.Duration, rrule string, exDates []time.Time, tentative bool) error //nolint:lll
+	CreateFeedToken(ctx context.Context, userID string) (string, error)
+	DeleteCalendar(ctx context.Context, userID, calendarID string) error
+	DeleteEvent(ctx context.Context, userID, eventID string) error
+	GrantAccess(ctx context.Context, ownerID, granteeID string, permission storage.Permission) error
+	ImportEvents(ctx context.Context, userID, calendarID string, data io.Reader) ([]*ImportResult, error)
+	InviteAttendees(ctx context.Context, userID, eventID string, attendeeIDs []string, role storage.AttendeeRole) error
+	ListCalendars(ctx context.Context, userID string) ([]*storage.Calendar, error)
+	ListEvents(ctx context.Context, params *ListEventsParams) (*EventsPage, error)
+	ListGrants(ctx context.Context, ownerID string) ([]*storage.Grant, error)
+	ListInvitations(ctx context.Context, userID string) ([]*storage.Event, error)
+	QueryFreeBusy(ctx context.Context, userIDs []string, from, to time.Time) ([]*FreeBusy, error)
+	ReadDailyEvents(ctx context.Context, userID string, date time.Time, timeZone string, calendarIDs []string) ([]*storage.Event, error)   //nolint:lll
+	ReadWeeklyEvents(ctx context.Context, userID string, date time.Time, timeZone string, calendarIDs []string) ([]*storage.Event, error)  //nolint:lll
+	ReadMonthlyEvents(ctx context.Context, userID string, date time.Time, timeZone string, calendarIDs []string) ([]*storage.Event, error) //nolint:lll
+	SearchEvents(ctx context.Context, userID, query string, calendarIDs []string) ([]*storage.Event, error)
+	ResolveFeedToken(ctx context.Context, token string) (string, error)
+	RespondToInvitation(ctx context.Context, userID, eventID string, status storage.RSVPStatus) error
+	RevokeAccess(ctx context.Context, ownerID, granteeID string) error
+	SetTimeZone(ctx context.Context, userID, timeZone string) error
+	UpdateCalendar(ctx context.Context, userID, calendarID, name, color string, defaultNotifyInterval time.Duration) error                                                                                 //nolint:lll
+	UpdateEvent(ctx context.Context, id, title, description, userID, calendarID string, startsAt, endAt *time.Time, notifyInterval time.Duration, rrule string, exDates []time.Time, tentative bool) error //nolint:lll
+	WatchEvents(ctx context.Context, userID string) (<-chan *EventChange, error)
 }
@@ -1,6 +1,10 @@
 package app
 
-import "time"
+import (
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+)
 
 // EventNotification структура уведомления о событии.
 type EventNotification struct {
@@ -9,3 +13,71 @@ type EventNotification struct {
 	EventDate  time.Time `json:"event_date,omitempty"`
 	UserID     string    `json:"user_id,omitempty"`
 }
+
+// ImportStatus строковый алиас для статуса импорта события.
+type ImportStatus = string
+
+// Статусы импорта события.
+const (
+	ImportStatusCreated ImportStatus = "created"
+	ImportStatusSkipped ImportStatus = "skipped"
+	ImportStatusFailed  ImportStatus = "failed"
+)
+
+// ImportResult результат импорта отдельного события.
+type ImportResult struct {
+	UID    string
+	Status ImportStatus
+	Err    error
+}
+
+// BusyInterval интервал занятости пользователя.
+type BusyInterval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// FreeBusy объединенные интервалы занятости пользователя.
+type FreeBusy struct {
+	UserID string
+	Busy   []BusyInterval
+}
+
+// ChangeType строковый алиас для типа изменения события.
+type ChangeType = string
+
+// Типы изменения события.
+const (
+	ChangeTypeCreated ChangeType = "created"
+	ChangeTypeUpdated ChangeType = "updated"
+	ChangeTypeDeleted ChangeType = "deleted"
+)
+
+// EventChange запись об изменении события пользователя UserID, владельца события. Для удаленного
+// события Event содержит его последнее состояние.
+type EventChange struct {
+	Type      ChangeType
+	EventID   string
+	UserID    string
+	Event     *storage.Event
+	ChangedAt time.Time
+}
+
+// ListEventsParams параметры постраничного чтения событий пользователя, см. storage.ListEventsQuery.
+// PageToken - непрозрачный токен следующей страницы из предыдущего ответа.
+type ListEventsParams struct {
+	UserID      string
+	CalendarIDs []string
+	From        time.Time
+	To          time.Time
+	Query       string
+	Order       storage.SortOrder
+	PageSize    int
+	PageToken   string
+}
+
+// EventsPage страница событий. NextPageToken пуст на последней странице.
+type EventsPage struct {
+	Events        []*storage.Event
+	NextPageToken string
+}
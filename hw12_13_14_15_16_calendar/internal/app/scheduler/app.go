@@ -3,15 +3,29 @@ package scheduler
 import (
 	"context"
 	"encoding/json"
+	"expvar"
 	"time"
 
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
 )
 
+// relayBatchSize количество уведомлений, читаемых из outbox за один запрос.
+const relayBatchSize = 100
+
+// Метрики очистки старых событий, доступные через expvar.
+var (
+	purgeRunsTotal   = expvar.NewInt("scheduler_purge_runs_total")
+	purgeErrorsTotal = expvar.NewInt("scheduler_purge_errors_total")
+	purgedEvents     = expvar.NewInt("scheduler_purged_events_total")
+)
+
 // IPublisherMQ интерфейс отправителя очереди сообщений.
+// Publish возвращает ошибку, если брокер не подтвердил сообщение или не смог направить его
+// в очередь; такое уведомление остается в outbox до следующей попытки.
 type IPublisherMQ interface {
 	Publish(ctx context.Context, msg []byte) error
 	Close() error
@@ -19,7 +33,10 @@ type IPublisherMQ interface {
 
 // IRepository интерфейс БД.
 type IRepository interface {
-	ReadEventsToNotify(ctx context.Context) ([]*storage.Event, error)
+	EnqueueNotifications(ctx context.Context) (int, error)
+	ReadPendingNotifications(ctx context.Context, limit int) ([]*storage.Notification, error)
+	MarkNotificationsSent(ctx context.Context, ids []int64) error
+	DeleteEventsEndedBefore(ctx context.Context, before time.Time) (int64, error)
 	Connect(ctx context.Context, dsn string) error
 	Close()
 }
@@ -28,52 +45,126 @@ type IRepository interface {
 type App struct {
 	repo      IRepository
 	publisher IPublisherMQ
+	cfg       config.SchedulerConfig
 }
 
 // NewApp конструктор приложения планировщика.
-func NewApp(repo IRepository, publisher IPublisherMQ) *App {
+func NewApp(repo IRepository, publisher IPublisherMQ, cfg config.SchedulerConfig) *App {
 	return &App{
 		repo:      repo,
 		publisher: publisher,
+		cfg:       cfg,
 	}
 }
 
-// Run запускает периодическое сканирование БД и отправку уведомлений о событиях в очередь.
-func (a *App) Run(ctx context.Context, tickd time.Duration) error {
-	ticker := time.NewTicker(tickd)
+// Run запускает периодическое сканирование БД и отправку уведомлений о событиях в очередь,
+// а также очистку событий старше RetentionPeriod, если она включена.
+func (a *App) Run(ctx context.Context) error {
+	ticker := time.NewTicker(a.cfg.DBReadInterval)
 	defer ticker.Stop()
 
+	// nil канал никогда не готов к чтению, поэтому без настроенной очистки ветка select не сработает
+	var purgeC <-chan time.Time
+	if a.cfg.RetentionPeriod > 0 && a.cfg.PurgeInterval > 0 {
+		purgeTicker := time.NewTicker(a.cfg.PurgeInterval)
+		defer purgeTicker.Stop()
+
+		purgeC = purgeTicker.C
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
 			return errors.Wrap(ctx.Err(), "[scheduler::Run]")
+		case <-purgeC:
+			a.purge(ctx)
 		case <-ticker.C:
-			events, err := a.repo.ReadEventsToNotify(ctx)
-			if err != nil {
-				return errors.Wrap(err, "[scheduler::Run]: failed to read events from DB")
+			if err := a.notify(ctx); err != nil {
+				return errors.Wrap(err, "[scheduler::Run]")
+			}
+		}
+	}
+}
+
+// notify помещает в outbox уведомления о событиях, о которых пора напомнить, и отправляет их в очередь.
+func (a *App) notify(ctx context.Context) error {
+	if _, err := a.repo.EnqueueNotifications(ctx); err != nil {
+		return errors.Wrap(err, "failed to enqueue notifications")
+	}
+
+	return a.relay(ctx)
+}
+
+// relay публикует неотправленные уведомления из outbox и отмечает отправленными только те,
+// публикацию которых подтвердил брокер. Неудачная публикация будет повторена на следующем тике,
+// поэтому доставка гарантируется как "at least once".
+func (a *App) relay(ctx context.Context) error {
+	for {
+		notifications, err := a.repo.ReadPendingNotifications(ctx, relayBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "failed to read outbox")
+		}
+
+		sent := make([]int64, 0, len(notifications))
+
+		var publishErr error
+		for _, notification := range notifications {
+			data, inErr := json.Marshal(app.EventNotification{
+				EventID:    notification.EventID,
+				EventTitle: notification.Title,
+				EventDate:  notification.StartsAt,
+				UserID:     notification.UserID,
+			})
+			if inErr != nil {
+				return errors.Wrap(inErr, "can't marshal notification")
+			}
+
+			if publishErr = a.publisher.Publish(ctx, data); publishErr != nil {
+				break
 			}
 
-			for _, event := range events {
-				notification := app.EventNotification{
-					EventID:    event.ID,
-					EventTitle: event.Title,
-					EventDate:  *event.StartsAt,
-					UserID:     event.UserID,
-				}
-
-				data, inErr := json.Marshal(notification)
-				if inErr != nil {
-					return errors.Wrap(inErr, "[scheduler::Run]: can't marshal notification")
-				}
-
-				if err = a.publisher.Publish(ctx, data); err != nil {
-					return errors.Wrap(err, "[scheduler::Run]: failed to publish amqp message")
-				}
+			sent = append(sent, notification.ID)
+		}
+
+		if len(sent) > 0 {
+			if err = a.repo.MarkNotificationsSent(ctx, sent); err != nil {
+				return errors.Wrap(err, "failed to mark notifications as sent")
 			}
 		}
+
+		if publishErr != nil {
+			log.Error().Err(publishErr).Int("pending", len(notifications)-len(sent)).
+				Msg("failed to publish notifications, will retry on next tick")
+
+			return nil
+		}
+
+		if len(notifications) < relayBatchSize {
+			return nil
+		}
 	}
 }
 
+// purge удаляет события, закончившиеся раньше, чем RetentionPeriod назад.
+// Ошибка очистки не останавливает планировщик: она будет повторена на следующем тике.
+func (a *App) purge(ctx context.Context) {
+	before := time.Now().UTC().Add(-a.cfg.RetentionPeriod)
+
+	purgeRunsTotal.Add(1)
+
+	deleted, err := a.repo.DeleteEventsEndedBefore(ctx, before)
+	purgedEvents.Add(deleted)
+
+	if err != nil {
+		purgeErrorsTotal.Add(1)
+		log.Error().Err(err).Int64("deleted", deleted).Msg("failed to purge old events")
+
+		return
+	}
+
+	log.Info().Int64("deleted", deleted).Time("ended_before", before).Msg("old events purged")
+}
+
 // Stop закрывает amqp соединение.
 func (a *App) Stop() error {
 	if err := a.publisher.Close(); err != nil {
@@ -0,0 +1,102 @@
+package scheduler_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/scheduler"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender/adapters"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/clients/memorymq"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	memorystorage "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage/memory"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyNotifier не доставляет первое уведомление каждого события и запоминает доставленные.
+type flakyNotifier struct {
+	mu        sync.Mutex
+	failed    map[string]bool
+	delivered chan *app.EventNotification
+}
+
+func (n *flakyNotifier) Notify(_ context.Context, notification *app.EventNotification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if !n.failed[notification.EventID] {
+		n.failed[notification.EventID] = true
+		return errors.New("channel is down")
+	}
+
+	n.delivered <- notification
+	return nil
+}
+
+func TestPipelineInMemory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := memorystorage.New()
+	now := time.Now().UTC()
+	startsAt, endsAt := now.Add(time.Minute), now.Add(time.Hour)
+	processed := false
+
+	event := &storage.Event{
+		Title:          "Standup",
+		StartsAt:       &startsAt,
+		EndsAt:         &endsAt,
+		UserID:         "user",
+		NotifyInterval: time.Hour,
+		Processed:      &processed,
+	}
+	require.NoError(t, repo.CreateEvent(ctx, event))
+
+	broker := memorymq.NewBroker(config.MessageQueueConfig{
+		Prefetch:    1,
+		RetryDelays: []time.Duration{time.Millisecond},
+	})
+
+	notifier := &flakyNotifier{
+		failed:    make(map[string]bool),
+		delivered: make(chan *app.EventNotification, 1),
+	}
+
+	schedulerApp := scheduler.NewApp(repo, broker, config.SchedulerConfig{DBReadInterval: 10 * time.Millisecond})
+	senderApp := sender.NewApp(adapters.NewMemoryClient(broker), notifier)
+
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		require.NoError(t, senderApp.Run(ctx))
+	}()
+	go func() {
+		defer wg.Done()
+		require.ErrorIs(t, schedulerApp.Run(ctx), context.Canceled)
+	}()
+
+	select {
+	case notification := <-notifier.delivered:
+		require.Equal(t, event.ID, notification.EventID)
+		require.Equal(t, "Standup", notification.EventTitle)
+		require.Equal(t, "user", notification.UserID)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "notification was not delivered")
+	}
+
+	cancel()
+	wg.Wait()
+	require.NoError(t, schedulerApp.Stop())
+
+	pending, err := repo.ReadPendingNotifications(context.Background(), 10)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+	require.Empty(t, broker.DeadLetters())
+}
@@ -0,0 +1,38 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/clients/memorymq"
+	"github.com/pkg/errors"
+)
+
+// ClientMemory адаптер очереди сообщений в памяти процесса для сервиса рассыльщика.
+type ClientMemory struct {
+	*memorymq.Broker
+}
+
+// NewMemoryClient конструктор адаптера очереди сообщений в памяти процесса для сервиса рассыльщика.
+func NewMemoryClient(broker *memorymq.Broker) *ClientMemory {
+	return &ClientMemory{broker}
+}
+
+// Consume адаптация Consume метода очереди сообщений в памяти процесса для сервиса рассыльщика.
+func (c *ClientMemory) Consume(ctx context.Context) (<-chan sender.IDeliveryMQ, error) {
+	msgs, err := c.Broker.Consume(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[adaptermq::Consume]")
+	}
+
+	deliveries := make(chan sender.IDeliveryMQ)
+
+	go func() {
+		defer close(deliveries)
+		for msg := range msgs {
+			deliveries <- msg
+		}
+	}()
+
+	return deliveries, nil
+}
@@ -0,0 +1,44 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/clients/natsjs"
+	"github.com/pkg/errors"
+)
+
+// ClientNATS адаптер NATS JetStream клиента для сервиса рассыльщика.
+type ClientNATS struct {
+	*natsjs.Client
+}
+
+// NewNATSClient конструктор адаптера NATS JetStream клиента для сервиса рассыльщика.
+func NewNATSClient(cfg config.MessageQueueConfig) (*ClientNATS, error) {
+	client, err := natsjs.NewClient(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "[adaptermq::NewClient]")
+	}
+
+	return &ClientNATS{client}, nil
+}
+
+// Consume адаптация Consume метода NATS JetStream клиента для сервиса рассыльщика.
+func (c *ClientNATS) Consume(ctx context.Context) (<-chan sender.IDeliveryMQ, error) {
+	msgs, err := c.Client.Consume(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[adaptermq::Consume]")
+	}
+
+	deliveries := make(chan sender.IDeliveryMQ)
+
+	go func() {
+		defer close(deliveries)
+		for msg := range msgs {
+			deliveries <- msg
+		}
+	}()
+
+	return deliveries, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/clients/rabbitmq"
 	"github.com/pkg/errors"
 	amqp "github.com/rabbitmq/amqp091-go"
@@ -12,6 +13,7 @@ import (
 // Delivery адаптер RabbitMQ сообщения для сервиса планировщика.
 type Delivery struct {
 	amqp.Delivery
+	client *rabbitmq.Client
 }
 
 // GetBody возвращает тело RabbitMQ сообщения.
@@ -19,14 +21,19 @@ func (d Delivery) GetBody() []byte {
 	return d.Body
 }
 
+// Retry откладывает RabbitMQ сообщение в очередь повторов или очередь недоставленных.
+func (d Delivery) Retry(ctx context.Context) error {
+	return d.client.Retry(ctx, d.Delivery)
+}
+
 // ClientRMQ адаптер RabbitMQ клиента для сервиса планировщика.
 type ClientRMQ struct {
 	*rabbitmq.Client
 }
 
 // NewRabbitMQClient конструктор адаптера RabbitMQ клиента для сервиса планировщика.
-func NewRabbitMQClient(url, queue string) (*ClientRMQ, error) {
-	client, err := rabbitmq.NewClient(url, queue)
+func NewRabbitMQClient(cfg config.MessageQueueConfig) (*ClientRMQ, error) {
+	client, err := rabbitmq.NewClient(cfg)
 	if err != nil {
 		return nil, errors.Wrap(err, "[adaptermq::NewClient]")
 	}
@@ -46,7 +53,7 @@ func (c *ClientRMQ) Consume(ctx context.Context) (<-chan sender.IDeliveryMQ, err
 	go func() {
 		defer close(deliveries)
 		for msg := range msgs {
-			deliveries <- Delivery{msg}
+			deliveries <- Delivery{Delivery: msg, client: c.Client}
 		}
 	}()
 
@@ -16,26 +16,39 @@ type IConsumerMQ interface {
 }
 
 // IDeliveryMQ интерфейс сообщения из очереди сообщений.
+// Retry откладывает сообщение для повторной обработки с задержкой, а после исчерпания
+// попыток отправляет его в очередь недоставленных.
 type IDeliveryMQ interface {
 	Ack(multiple bool) error
 	Reject(requeue bool) error
 	Nack(multiple bool, requeue bool) error
+	Retry(ctx context.Context) error
 	GetBody() []byte
 }
 
+// INotifier интерфейс канала доставки уведомлений.
+type INotifier interface {
+	Notify(ctx context.Context, notification *app.EventNotification) error
+}
+
 // App структура приложения рассыльщика.
 type App struct {
 	consumer IConsumerMQ
+	notifier INotifier
 }
 
 // NewApp конструктор приложения рассыльщика.
-func NewApp(consumer IConsumerMQ) *App {
+func NewApp(consumer IConsumerMQ, notifier INotifier) *App {
 	return &App{
 		consumer: consumer,
+		notifier: notifier,
 	}
 }
 
-// Run запускает непрерывное чтение сообщений из очереди.
+// Run запускает непрерывное чтение сообщений из очереди и доставку уведомлений.
+// Сообщение подтверждается только после успешной доставки, при ошибке доставки
+// оно откладывается для повторной попытки, а некорректное сообщение отклоняется
+// в очередь недоставленных.
 func (a *App) Run(ctx context.Context) error {
 	log.Info().Msg("[sender::Run]: start consuming...")
 
@@ -43,9 +56,10 @@ func (a *App) Run(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "[sender::Run]")
 	}
-	var notification app.EventNotification
 
 	for msg := range msgs {
+		var notification app.EventNotification
+
 		body := msg.GetBody()
 		if err = json.Unmarshal(body, &notification); err != nil {
 			log.Error().Err(err).Bytes("notification", body).Msg(
@@ -58,6 +72,28 @@ func (a *App) Run(ctx context.Context) error {
 			continue
 		}
 
+		if err = a.notifier.Notify(ctx, &notification); err != nil {
+			log.Error().Err(err).RawJSON("notification", body).Msg(
+				"[sender::Run]: failed to deliver notification")
+			if err = msg.Retry(ctx); err != nil {
+				log.Error().Err(err).RawJSON("notification", body).Msg(
+					"[sender::Run]: failed to schedule retry, requeueing amqp message")
+				if err = msg.Nack(false, true); err != nil {
+					log.Error().Err(err).RawJSON("notification", body).Msg(
+						"[sender::Run]: failed to nack amqp message")
+				}
+			}
+
+			continue
+		}
+
+		if err = msg.Ack(false); err != nil {
+			log.Error().Err(err).RawJSON("notification", body).Msg(
+				"[sender::Run]: failed to ack amqp message")
+
+			continue
+		}
+
 		log.Info().RawJSON("notification", body).Msg("notification sent successfully")
 	}
 
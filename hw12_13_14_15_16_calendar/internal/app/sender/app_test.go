@@ -0,0 +1,89 @@
+package sender
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type delivery struct {
+	body    []byte
+	outcome string
+}
+
+func (d *delivery) Ack(_ bool) error {
+	d.outcome = "ack"
+	return nil
+}
+
+func (d *delivery) Reject(requeue bool) error {
+	d.outcome = "reject"
+	if requeue {
+		d.outcome += "+requeue"
+	}
+	return nil
+}
+
+func (d *delivery) Nack(_ bool, requeue bool) error {
+	d.outcome = "nack"
+	if requeue {
+		d.outcome += "+requeue"
+	}
+	return nil
+}
+
+func (d *delivery) Retry(_ context.Context) error {
+	d.outcome = "retry"
+	return nil
+}
+
+func (d *delivery) GetBody() []byte {
+	return d.body
+}
+
+type consumer struct {
+	deliveries []*delivery
+}
+
+func (c *consumer) Consume(_ context.Context) (<-chan IDeliveryMQ, error) {
+	msgs := make(chan IDeliveryMQ, len(c.deliveries))
+	for _, d := range c.deliveries {
+		msgs <- d
+	}
+	close(msgs)
+
+	return msgs, nil
+}
+
+func (c *consumer) Close() error {
+	return nil
+}
+
+type notifierFunc func(ctx context.Context, notification *app.EventNotification) error
+
+func (f notifierFunc) Notify(ctx context.Context, notification *app.EventNotification) error {
+	return f(ctx, notification)
+}
+
+func TestRun(t *testing.T) {
+	delivered := &delivery{body: []byte(`{"event_id":"ok","user_id":"user"}`)}
+	failed := &delivery{body: []byte(`{"event_id":"fail","user_id":"user"}`)}
+	malformed := &delivery{body: []byte(`{`)}
+
+	notifier := notifierFunc(func(_ context.Context, notification *app.EventNotification) error {
+		if notification.EventID == "fail" {
+			return errors.New("channel is down")
+		}
+		return nil
+	})
+
+	a := NewApp(&consumer{deliveries: []*delivery{delivered, failed, malformed}}, notifier)
+	require.NoError(t, a.Run(context.Background()))
+
+	require.Equal(t, "ack", delivered.outcome)
+	require.Equal(t, "retry", failed.outcome)
+	require.Equal(t, "reject", malformed.outcome)
+}
@@ -0,0 +1,60 @@
+package notifiers
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/pkg/errors"
+)
+
+// ParkedDelivery окончательно неудавшаяся доставка, отложенная для разбора.
+type ParkedDelivery struct {
+	Notification *app.EventNotification `json:"notification"`
+	URL          string                 `json:"url"`
+	Attempts     int                    `json:"attempts"`
+	Error        string                 `json:"error"`
+	ParkedAt     time.Time              `json:"parked_at"`
+}
+
+// IParkingLot интерфейс хранилища отложенных доставок.
+type IParkingLot interface {
+	Park(ctx context.Context, delivery *ParkedDelivery) error
+}
+
+// FileParkingLot хранилище отложенных доставок в файле формата JSON Lines.
+type FileParkingLot struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileParkingLot конструктор файлового хранилища отложенных доставок.
+func NewFileParkingLot(path string) *FileParkingLot {
+	return &FileParkingLot{path: path}
+}
+
+// Park дописывает доставку в конец файла.
+func (p *FileParkingLot) Park(_ context.Context, delivery *ParkedDelivery) error {
+	data, err := json.Marshal(delivery)
+	if err != nil {
+		return errors.Wrap(err, "[notifiers::Park]: can't marshal delivery")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	file, err := os.OpenFile(p.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "[notifiers::Park]: can't open %s", p.path)
+	}
+
+	if _, err = file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return errors.Wrapf(err, "[notifiers::Park]: can't write to %s", p.path)
+	}
+
+	return errors.Wrapf(file.Close(), "[notifiers::Park]: can't close %s", p.path)
+}
@@ -0,0 +1,89 @@
+package notifiers
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/pkg/errors"
+)
+
+// Router канал доставки, выбирающий канал по пользователю уведомления.
+type Router struct {
+	fallback sender.INotifier
+	byUser   map[string]sender.INotifier
+}
+
+// NewRouter конструктор маршрутизатора уведомлений.
+func NewRouter(fallback sender.INotifier, byUser map[string]sender.INotifier) *Router {
+	return &Router{
+		fallback: fallback,
+		byUser:   byUser,
+	}
+}
+
+// Notify доставляет уведомление через канал пользователя или канал по умолчанию.
+func (r *Router) Notify(ctx context.Context, notification *app.EventNotification) error {
+	if notifier, ok := r.byUser[notification.UserID]; ok {
+		return notifier.Notify(ctx, notification)
+	}
+
+	return r.fallback.Notify(ctx, notification)
+}
+
+// New собирает канал доставки уведомлений по конфигу рассыльщика.
+// Канал stdout пишет в out.
+func New(cfg config.SenderConfig, out io.Writer) (*Router, error) {
+	client := &http.Client{Timeout: cfg.Webhook.Timeout}
+
+	var parking IParkingLot
+	if cfg.Webhook.ParkFile != "" {
+		parking = NewFileParkingLot(cfg.Webhook.ParkFile)
+	}
+
+	build := func(notifierCfg config.NotifierConfig) (sender.INotifier, error) {
+		return newNotifier(cfg, notifierCfg, client, parking, out)
+	}
+
+	fallback, err := build(cfg.Default)
+	if err != nil {
+		return nil, errors.Wrap(err, "[notifiers::New]: default channel")
+	}
+
+	byUser := make(map[string]sender.INotifier, len(cfg.Users))
+	for userID, userCfg := range cfg.Users {
+		if byUser[userID], err = build(userCfg); err != nil {
+			return nil, errors.Wrapf(err, "[notifiers::New]: channel of user %s", userID)
+		}
+	}
+
+	return NewRouter(fallback, byUser), nil
+}
+
+func newNotifier(
+	cfg config.SenderConfig,
+	notifierCfg config.NotifierConfig,
+	client *http.Client,
+	parking IParkingLot,
+	out io.Writer,
+) (sender.INotifier, error) {
+	switch notifierCfg.Channel {
+	case config.NotifierChannelStdout, "":
+		return NewStdout(out), nil
+	case config.NotifierChannelSMTP:
+		if notifierCfg.Address == "" {
+			return nil, errors.New("email address is required")
+		}
+		return NewSMTP(cfg.SMTP, notifierCfg.Address), nil
+	case config.NotifierChannelWebhook:
+		if notifierCfg.Address == "" {
+			return nil, errors.New("webhook URL is required")
+		}
+		return NewWebhook(client, notifierCfg.Address, cfg.Webhook, parking), nil
+	default:
+		return nil, errors.Errorf("unknown channel %q", notifierCfg.Channel)
+	}
+}
@@ -0,0 +1,51 @@
+package notifiers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter(t *testing.T) {
+	var fallback, personal bytes.Buffer
+
+	router := NewRouter(NewStdout(&fallback), map[string]sender.INotifier{"vip": NewStdout(&personal)})
+
+	notification := testNotification()
+	require.NoError(t, router.Notify(context.Background(), notification))
+
+	notification.UserID = "vip"
+	require.NoError(t, router.Notify(context.Background(), notification))
+
+	var got app.EventNotification
+	require.NoError(t, json.Unmarshal(fallback.Bytes(), &got))
+	require.Equal(t, "user", got.UserID)
+	require.NoError(t, json.Unmarshal(personal.Bytes(), &got))
+	require.Equal(t, "vip", got.UserID)
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(config.SenderConfig{
+		Default: config.NotifierConfig{Channel: config.NotifierChannelStdout},
+		Users: map[string]config.NotifierConfig{
+			"user": {Channel: config.NotifierChannelWebhook, Address: "http://localhost/hook"},
+		},
+	}, &bytes.Buffer{})
+	require.NoError(t, err)
+
+	_, err = New(config.SenderConfig{
+		Default: config.NotifierConfig{Channel: config.NotifierChannelSMTP},
+	}, &bytes.Buffer{})
+	require.Error(t, err)
+
+	_, err = New(config.SenderConfig{
+		Default: config.NotifierConfig{Channel: "pigeon"},
+	}, &bytes.Buffer{})
+	require.Error(t, err)
+}
@@ -0,0 +1,114 @@
+package notifiers
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/pkg/errors"
+)
+
+// SMTP канал доставки, отправляющий уведомления по email.
+type SMTP struct {
+	cfg config.SMTPConfig
+	to  string
+}
+
+// NewSMTP конструктор канала доставки по email на адрес to.
+func NewSMTP(cfg config.SMTPConfig, to string) *SMTP {
+	return &SMTP{
+		cfg: cfg,
+		to:  to,
+	}
+}
+
+// Notify отправляет письмо с напоминанием о событии.
+// STARTTLS и аутентификация используются, если их поддерживает сервер и задан Username.
+func (s *SMTP) Notify(ctx context.Context, notification *app.EventNotification) error {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", s.cfg.GetAddr())
+	if err != nil {
+		return errors.Wrapf(err, "[notifiers::SMTP]: can't connect to %s", s.cfg.GetAddr())
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return errors.Wrap(err, "[notifiers::SMTP]: can't set deadline")
+		}
+	}
+
+	client, err := smtp.NewClient(conn, s.cfg.Host)
+	if err != nil {
+		return errors.Wrap(err, "[notifiers::SMTP]: can't start session")
+	}
+	defer client.Close()
+
+	if err = s.send(client, notification); err != nil {
+		return errors.Wrapf(err, "[notifiers::SMTP]: can't send email to %s", s.to)
+	}
+
+	return nil
+}
+
+func (s *SMTP) send(client *smtp.Client, notification *app.EventNotification) error {
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.cfg.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+
+	if ok, _ := client.Extension("AUTH"); ok && s.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(s.cfg.From); err != nil {
+		return err
+	}
+
+	if err := client.Rcpt(s.to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err = w.Write(s.message(notification)); err != nil {
+		return err
+	}
+
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// message формирует письмо в формате RFC 5322.
+func (s *SMTP) message(notification *app.EventNotification) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "From: %s\r\n", s.cfg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", s.to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", "Reminder: "+notification.EventTitle))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	fmt.Fprintf(&b, "Event %q starts at %s.\r\n", notification.EventTitle, notification.EventDate.Format(time.RFC1123Z))
+
+	return b.Bytes()
+}
@@ -0,0 +1,122 @@
+package notifiers
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+// mail письмо, принятое тестовым SMTP сервером.
+type mail struct {
+	from string
+	to   []string
+	data string
+}
+
+// serveSMTP запускает минимальный SMTP сервер, принимающий одно соединение.
+// rejectRcpt заставляет сервер отклонять получателей.
+func serveSMTP(t *testing.T, rejectRcpt bool) (string, <-chan mail) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	mails := make(chan mail, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+
+		var m mail
+
+		_ = tp.PrintfLine("220 localhost ESMTP test")
+
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+
+			cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+			switch {
+			case cmd == "EHLO" || cmd == "HELO":
+				_ = tp.PrintfLine("250 localhost")
+			case strings.HasPrefix(strings.ToUpper(line), "MAIL FROM:"):
+				m.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+				_ = tp.PrintfLine("250 OK")
+			case strings.HasPrefix(strings.ToUpper(line), "RCPT TO:"):
+				if rejectRcpt {
+					_ = tp.PrintfLine("550 no such user")
+					continue
+				}
+				m.to = append(m.to, strings.Trim(line[len("RCPT TO:"):], "<>"))
+				_ = tp.PrintfLine("250 OK")
+			case cmd == "DATA":
+				_ = tp.PrintfLine("354 go ahead")
+
+				data, err := tp.ReadDotLines()
+				if err != nil {
+					return
+				}
+				m.data = strings.Join(data, "\n")
+				_ = tp.PrintfLine("250 OK")
+			case cmd == "QUIT":
+				_ = tp.PrintfLine("221 bye")
+				mails <- m
+				return
+			default:
+				_ = tp.PrintfLine("502 not implemented")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), mails
+}
+
+func smtpConfig(t *testing.T, addr string) config.SMTPConfig {
+	t.Helper()
+
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	return config.SMTPConfig{Host: host, Port: port, From: "calendar@localhost"}
+}
+
+func TestSMTP(t *testing.T) {
+	t.Run("sends reminder email", func(t *testing.T) {
+		addr, mails := serveSMTP(t, false)
+
+		err := NewSMTP(smtpConfig(t, addr), "user@example.com").Notify(context.Background(), testNotification())
+		require.NoError(t, err)
+
+		m := <-mails
+		require.Equal(t, "calendar@localhost", m.from)
+		require.Equal(t, []string{"user@example.com"}, m.to)
+
+		msg, err := textproto.NewReader(bufio.NewReader(strings.NewReader(m.data + "\n"))).ReadMIMEHeader()
+		require.NoError(t, err)
+		require.Equal(t, "user@example.com", msg.Get("To"))
+		require.Equal(t, "=?utf-8?q?Reminder:_=D0=92=D1=81=D1=82=D1=80=D0=B5=D1=87=D0=B0?=", msg.Get("Subject"))
+		require.Contains(t, m.data, `Event "Встреча" starts at Mon, 06 Jan 2025 10:00:00 +0000.`)
+	})
+
+	t.Run("rejected recipient is an error", func(t *testing.T) {
+		addr, _ := serveSMTP(t, true)
+
+		err := NewSMTP(smtpConfig(t, addr), "nobody@example.com").Notify(context.Background(), testNotification())
+		require.ErrorContains(t, err, "550")
+	})
+}
@@ -0,0 +1,37 @@
+package notifiers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/pkg/errors"
+)
+
+// Stdout канал доставки, выводящий уведомления построчно в формате JSON.
+type Stdout struct {
+	w  io.Writer
+	mu sync.Mutex
+}
+
+// NewStdout конструктор канала доставки в поток вывода.
+func NewStdout(w io.Writer) *Stdout {
+	return &Stdout{w: w}
+}
+
+// Notify выводит уведомление.
+func (s *Stdout) Notify(_ context.Context, notification *app.EventNotification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return errors.Wrap(err, "[notifiers::Stdout]: can't marshal notification")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err = s.w.Write(append(data, '\n'))
+
+	return errors.Wrap(err, "[notifiers::Stdout]: can't write notification")
+}
@@ -0,0 +1,31 @@
+package notifiers
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender"
+)
+
+// Tee канал доставки, последовательно доставляющий уведомление через все каналы.
+// Ошибка любого канала прерывает доставку, и повторная попытка начнется с первого канала,
+// поэтому каналы после первого должны быть идемпотентны.
+type Tee struct {
+	notifiers []sender.INotifier
+}
+
+// NewTee конструктор канала доставки через несколько каналов.
+func NewTee(notifiers ...sender.INotifier) *Tee {
+	return &Tee{notifiers: notifiers}
+}
+
+// Notify доставляет уведомление через все каналы по порядку.
+func (t *Tee) Notify(ctx context.Context, notification *app.EventNotification) error {
+	for _, notifier := range t.notifiers {
+		if err := notifier.Notify(ctx, notification); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
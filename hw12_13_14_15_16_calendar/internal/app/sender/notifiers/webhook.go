@@ -0,0 +1,174 @@
+package notifiers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/pkg/errors"
+)
+
+// Заголовки запроса вебхука.
+const (
+	HeaderSignature      = "X-Calendar-Signature"
+	HeaderTimestamp      = "X-Calendar-Timestamp"
+	HeaderIdempotencyKey = "Idempotency-Key"
+)
+
+// maxErrorBodyBytes сколько байт ответа вебхука попадает в текст ошибки.
+const maxErrorBodyBytes = 512
+
+// errPermanent ошибка доставки, которую бессмысленно повторять.
+var errPermanent = errors.New("permanent delivery failure")
+
+// Webhook канал доставки, отправляющий уведомления POST запросом с JSON телом.
+//
+// Тело подписывается HMAC-SHA256 от строки "<timestamp>.<body>" и передается в заголовке
+// X-Calendar-Signature в виде "sha256=<hex>", timestamp - в X-Calendar-Timestamp (unix секунды).
+// Idempotency-Key одинаков для всех попыток доставки одного уведомления.
+type Webhook struct {
+	client  *http.Client
+	url     string
+	cfg     config.WebhookConfig
+	parking IParkingLot
+}
+
+// NewWebhook конструктор канала доставки через HTTP вебхук.
+// Если parking не nil, окончательно неудавшиеся доставки откладываются в него
+// и считаются обработанными, иначе возвращается ошибка.
+func NewWebhook(client *http.Client, url string, cfg config.WebhookConfig, parking IParkingLot) *Webhook {
+	return &Webhook{
+		client:  client,
+		url:     url,
+		cfg:     cfg,
+		parking: parking,
+	}
+}
+
+// Notify отправляет уведомление, повторяя попытки при сетевых ошибках, ответах 429 и 5xx.
+func (w *Webhook) Notify(ctx context.Context, notification *app.EventNotification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return errors.Wrap(err, "[notifiers::Webhook]: can't marshal notification")
+	}
+
+	key := IdempotencyKey(notification)
+	attempts := max(w.cfg.MaxAttempts, 1)
+
+	var attempt int
+	for attempt = 1; ; attempt++ {
+		err = w.post(ctx, data, key)
+		if err == nil || errors.Is(err, errPermanent) || attempt == attempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "[notifiers::Webhook]")
+		case <-time.After(w.backoff(attempt)):
+		}
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	if w.parking == nil || ctx.Err() != nil {
+		return errors.Wrapf(err, "[notifiers::Webhook]: %d attempt(s) failed", attempt)
+	}
+
+	parkErr := w.parking.Park(ctx, &ParkedDelivery{
+		Notification: notification,
+		URL:          w.url,
+		Attempts:     attempt,
+		Error:        err.Error(),
+		ParkedAt:     time.Now().UTC(),
+	})
+
+	return errors.Wrap(parkErr, "[notifiers::Webhook]: can't park failed delivery")
+}
+
+// post выполняет одну попытку доставки.
+func (w *Webhook) post(ctx context.Context, data []byte, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrapf(errPermanent, "can't build request: %v", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderIdempotencyKey, key)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	if w.cfg.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(w.cfg.Secret, timestamp, data))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "can't deliver notification to %s", w.url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	err = errors.Errorf("%s responded with %s: %s", w.url, resp.Status, body)
+
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+		return errors.Wrap(errPermanent, err.Error())
+	}
+
+	return err
+}
+
+// backoff возвращает задержку перед следующей попыткой: случайную величину от 0
+// до InitialBackoff*2^(attempt-1), но не больше MaxBackoff ("full jitter").
+func (w *Webhook) backoff(attempt int) time.Duration {
+	if w.cfg.InitialBackoff <= 0 {
+		return 0
+	}
+
+	ceiling := w.cfg.InitialBackoff << min(attempt-1, 30)
+	if w.cfg.MaxBackoff > 0 && (ceiling <= 0 || ceiling > w.cfg.MaxBackoff) {
+		ceiling = w.cfg.MaxBackoff
+	}
+
+	if ceiling <= 0 {
+		return 0
+	}
+
+	return rand.N(ceiling) //nolint:gosec
+}
+
+// Sign возвращает значение заголовка X-Calendar-Signature для тела запроса.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// IdempotencyKey возвращает ключ идемпотентности уведомления, одинаковый для повторных доставок.
+// Уведомления разных получателей об одном вхождении события получают разные ключи.
+func IdempotencyKey(notification *app.EventNotification) string {
+	sum := sha256.Sum256([]byte(notification.EventID + "|" + notification.EventDate.UTC().Format(time.RFC3339Nano) +
+		"|" + notification.UserID))
+
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,185 @@
+package notifiers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func testNotification() *app.EventNotification {
+	return &app.EventNotification{
+		EventID:    "event",
+		EventTitle: "Встреча",
+		EventDate:  time.Date(2025, time.January, 6, 10, 0, 0, 0, time.UTC),
+		UserID:     "user",
+	}
+}
+
+var testWebhookConfig = config.WebhookConfig{
+	Secret:         "secret",
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+}
+
+// hook тестовый получатель вебхука, отвечающий кодами из statuses по очереди.
+type hook struct {
+	statuses []int
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (h *hook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.requests = append(h.requests, r)
+	h.bodies = append(h.bodies, body)
+
+	status := h.statuses[min(len(h.requests), len(h.statuses))-1]
+	w.WriteHeader(status)
+}
+
+func readParked(t *testing.T, path string) []*ParkedDelivery {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	defer file.Close()
+
+	var result []*ParkedDelivery
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var parked ParkedDelivery
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &parked))
+		result = append(result, &parked)
+	}
+
+	return result
+}
+
+func TestWebhook(t *testing.T) {
+	t.Run("signed delivery retried until success", func(t *testing.T) {
+		h := &hook{statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusNoContent}}
+		server := httptest.NewServer(h)
+		defer server.Close()
+
+		err := NewWebhook(server.Client(), server.URL, testWebhookConfig, nil).
+			Notify(context.Background(), testNotification())
+		require.NoError(t, err)
+		require.Len(t, h.requests, 3)
+
+		key := IdempotencyKey(testNotification())
+		for i, r := range h.requests {
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			require.Equal(t, key, r.Header.Get(HeaderIdempotencyKey))
+
+			timestamp := r.Header.Get(HeaderTimestamp)
+			require.Equal(t, Sign("secret", timestamp, h.bodies[i]), r.Header.Get(HeaderSignature))
+		}
+
+		var got app.EventNotification
+		require.NoError(t, json.Unmarshal(h.bodies[2], &got))
+		require.Equal(t, *testNotification(), got)
+	})
+
+	t.Run("recipients of the same occurrence get distinct keys", func(t *testing.T) {
+		h := &hook{statuses: []int{http.StatusNoContent}}
+		server := httptest.NewServer(h)
+		defer server.Close()
+
+		owner := testNotification()
+		attendee := testNotification()
+		attendee.UserID = "attendee"
+
+		webhook := NewWebhook(server.Client(), server.URL, testWebhookConfig, nil)
+		require.NoError(t, webhook.Notify(context.Background(), owner))
+		require.NoError(t, webhook.Notify(context.Background(), attendee))
+		require.Len(t, h.requests, 2)
+
+		require.Equal(t, IdempotencyKey(owner), h.requests[0].Header.Get(HeaderIdempotencyKey))
+		require.Equal(t, IdempotencyKey(attendee), h.requests[1].Header.Get(HeaderIdempotencyKey))
+		require.NotEqual(t, h.requests[0].Header.Get(HeaderIdempotencyKey), h.requests[1].Header.Get(HeaderIdempotencyKey))
+	})
+
+	t.Run("client error is parked without retries", func(t *testing.T) {
+		h := &hook{statuses: []int{http.StatusBadRequest}}
+		server := httptest.NewServer(h)
+		defer server.Close()
+
+		path := filepath.Join(t.TempDir(), "parked.jsonl")
+
+		err := NewWebhook(server.Client(), server.URL, testWebhookConfig, NewFileParkingLot(path)).
+			Notify(context.Background(), testNotification())
+		require.NoError(t, err)
+		require.Len(t, h.requests, 1)
+
+		parked := readParked(t, path)
+		require.Len(t, parked, 1)
+		require.Equal(t, 1, parked[0].Attempts)
+		require.Equal(t, server.URL, parked[0].URL)
+		require.Equal(t, testNotification(), parked[0].Notification)
+		require.Contains(t, parked[0].Error, "400")
+	})
+
+	t.Run("exhausted retries are parked", func(t *testing.T) {
+		h := &hook{statuses: []int{http.StatusServiceUnavailable}}
+		server := httptest.NewServer(h)
+		defer server.Close()
+
+		path := filepath.Join(t.TempDir(), "parked.jsonl")
+
+		err := NewWebhook(server.Client(), server.URL, testWebhookConfig, NewFileParkingLot(path)).
+			Notify(context.Background(), testNotification())
+		require.NoError(t, err)
+		require.Len(t, h.requests, 3)
+
+		parked := readParked(t, path)
+		require.Len(t, parked, 1)
+		require.Equal(t, 3, parked[0].Attempts)
+	})
+
+	t.Run("failure without parking lot is an error", func(t *testing.T) {
+		h := &hook{statuses: []int{http.StatusServiceUnavailable}}
+		server := httptest.NewServer(h)
+		defer server.Close()
+
+		err := NewWebhook(server.Client(), server.URL, testWebhookConfig, nil).
+			Notify(context.Background(), testNotification())
+		require.ErrorContains(t, err, "503")
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	w := NewWebhook(http.DefaultClient, "", config.WebhookConfig{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}, nil)
+
+	for attempt := 1; attempt <= 40; attempt++ {
+		ceiling := min(100*time.Millisecond<<min(attempt-1, 30), time.Second)
+
+		d := w.backoff(attempt)
+		require.GreaterOrEqual(t, d, time.Duration(0))
+		require.Less(t, d, ceiling)
+	}
+}
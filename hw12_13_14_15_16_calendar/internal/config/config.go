@@ -26,6 +26,16 @@ const (
 	DBTypeInMemory DBType = "in-memory"
 )
 
+// MQType строковый алиас для поддерживаемых типов очереди сообщений.
+type MQType = string
+
+// Поддерживаемые типы очереди сообщений.
+const (
+	MQTypeRabbitMQ MQType = "rabbitmq"
+	MQTypeNATS     MQType = "nats"
+	MQTypeInMemory MQType = "in-memory"
+)
+
 // DBConfig модель конфига для БД.
 type DBConfig struct {
 	DBType DBType `mapstructure:"db_type"`
@@ -53,15 +63,136 @@ func (sc *ServerConfig) GetAddr() string {
 	return fmt.Sprintf("%s:%s", sc.Host, sc.Port)
 }
 
-// MessageQueueConfig модель конфига для очереди сообщений.
+// MessageQueueConfig модель конфига для очереди сообщений. Для типа nats URL указывает на NATS сервер
+// с включенным JetStream, а Queue задает имя потока. Очередь типа in-memory живет в памяти
+// процесса календаря, который в этом случае сам запускает планировщик и рассыльщик.
+// RetryDelays задает задержки очередей повторов: после len(RetryDelays) неудачных
+// попыток сообщение попадает в очередь недоставленных. PublishTimeout ограничивает
+// ожидание подтверждения публикации брокером (0 - без ограничения). ReconnectBackoff задает
+// начальную паузу между попытками переподключения к брокеру, которая удваивается до ReconnectMaxBackoff.
 type MessageQueueConfig struct {
-	URL   string `mapstructure:"url"`
-	Queue string `mapstructure:"queue"`
+	Type                MQType          `mapstructure:"type"`
+	URL                 string          `mapstructure:"url"`
+	Queue               string          `mapstructure:"queue"`
+	Durable             bool            `mapstructure:"durable"`
+	Prefetch            int             `mapstructure:"prefetch"`
+	RetryDelays         []time.Duration `mapstructure:"retry_delays"`
+	PublishTimeout      time.Duration   `mapstructure:"publish_timeout"`
+	ReconnectBackoff    time.Duration   `mapstructure:"reconnect_backoff"`
+	ReconnectMaxBackoff time.Duration   `mapstructure:"reconnect_max_backoff"`
 }
 
 // SchedulerConfig модель конфига для сервиса-планировщика.
 type SchedulerConfig struct {
-	DBReadInterval time.Duration `mapstructure:"db_read_interval"`
+	DBReadInterval  time.Duration `mapstructure:"db_read_interval"`
+	PurgeInterval   time.Duration `mapstructure:"purge_interval"`
+	RetentionPeriod time.Duration `mapstructure:"retention_period"`
+}
+
+// NotifierChannel строковый алиас для каналов доставки уведомлений.
+type NotifierChannel = string
+
+// Поддерживаемые каналы доставки уведомлений.
+const (
+	NotifierChannelSMTP    NotifierChannel = "smtp"
+	NotifierChannelWebhook NotifierChannel = "webhook"
+	NotifierChannelStdout  NotifierChannel = "stdout"
+)
+
+// NotifierConfig модель конфига канала доставки уведомлений.
+// Address - email получателя для smtp или URL для webhook.
+type NotifierConfig struct {
+	Channel NotifierChannel `mapstructure:"channel"`
+	Address string          `mapstructure:"address"`
+}
+
+// SMTPConfig модель конфига SMTP сервера для отправки email уведомлений.
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     string `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+}
+
+// GetAddr возвращает строку вида "host:port".
+func (sc *SMTPConfig) GetAddr() string {
+	return fmt.Sprintf("%s:%s", sc.Host, sc.Port)
+}
+
+// WebhookConfig модель конфига для отправки уведомлений через HTTP вебхуки.
+// Запросы подписываются HMAC-SHA256 с ключом Secret, неудачные доставки повторяются
+// MaxAttempts раз с экспоненциальной задержкой, после чего откладываются в ParkFile.
+type WebhookConfig struct {
+	Timeout        time.Duration `mapstructure:"timeout"`
+	Secret         string        `mapstructure:"secret"`
+	MaxAttempts    int           `mapstructure:"max_attempts"`
+	InitialBackoff time.Duration `mapstructure:"initial_backoff"`
+	MaxBackoff     time.Duration `mapstructure:"max_backoff"`
+	ParkFile       string        `mapstructure:"park_file"`
+}
+
+// SenderConfig модель конфига для сервиса-рассыльщика.
+// Канал доставки выбирается по пользователю из Users, иначе используется Default.
+type SenderConfig struct {
+	Default NotifierConfig            `mapstructure:"default"`
+	Users   map[string]NotifierConfig `mapstructure:"users"`
+	SMTP    SMTPConfig                `mapstructure:"smtp"`
+	Webhook WebhookConfig             `mapstructure:"webhook"`
+}
+
+// OverlapPolicy строковый алиас для политик пересечения событий.
+type OverlapPolicy = string
+
+// Поддерживаемые политики пересечения событий.
+const (
+	// OverlapPolicyReject запрещает пересечение любых событий пользователя.
+	OverlapPolicyReject OverlapPolicy = "reject"
+	// OverlapPolicyAllow разрешает любые пересечения.
+	OverlapPolicyAllow OverlapPolicy = "allow"
+	// OverlapPolicyAllowTentative разрешает пересечения только с предварительными (tentative) событиями.
+	OverlapPolicyAllowTentative OverlapPolicy = "allow-tentative"
+)
+
+// CalendarConfig модель конфига для основного приложения календаря.
+// FeedSecret - секрет HMAC подписи токенов iCalendar-лент, который оператор должен задать сам:
+// знающий секрет может выпустить токен любого пользователя. Пока секрет пуст, ленты выключены.
+// DefaultTimeZone - IANA часовой пояс пользователей, не задавших свой (по умолчанию UTC).
+type CalendarConfig struct {
+	FeedSecret      string        `mapstructure:"feed_secret"`
+	OverlapPolicy   OverlapPolicy `mapstructure:"overlap_policy"`
+	DefaultTimeZone string        `mapstructure:"default_time_zone"`
+}
+
+// RemindersConfig модель конфига SSE потока доставленных напоминаний. Поток доступен только
+// при включенной аутентификации (см. AuthConfig).
+// Backlog - сколько последних напоминаний пользователя хранится для возобновления по Last-Event-ID,
+// BacklogTTL - как долго они хранятся, Heartbeat - период комментариев, поддерживающих соединение.
+// Непустой Secret включает прием напоминаний от рассыльщика, настроенного на канал webhook с тем же секретом.
+type RemindersConfig struct {
+	Backlog    int           `mapstructure:"backlog"`
+	BacklogTTL time.Duration `mapstructure:"backlog_ttl"`
+	Heartbeat  time.Duration `mapstructure:"heartbeat"`
+	Secret     string        `mapstructure:"secret"`
+}
+
+// AuthConfig модель конфига аутентификации по JWT. Токены проверяются общим секретом HMACSecret (HS256),
+// публичным ключом RS256 из PEM файла RSAPublicKeyFile и ключами из локального JWKS файла JWKSFile.
+// Непустые Issuer и Audience дополнительно сверяются с claims iss и aud, Leeway - допуск расхождения часов.
+// Если ни один ключ не задан, аутентификация выключена: grpc методы берут пользователя из полей запроса,
+// а HTTP поток напоминаний не обслуживается. Аутентификация HTTP маршрутов описана в пакете server.
+type AuthConfig struct {
+	HMACSecret       string        `mapstructure:"hmac_secret"`
+	RSAPublicKeyFile string        `mapstructure:"rsa_public_key_file"`
+	JWKSFile         string        `mapstructure:"jwks_file"`
+	Issuer           string        `mapstructure:"issuer"`
+	Audience         string        `mapstructure:"audience"`
+	Leeway           time.Duration `mapstructure:"leeway"`
+}
+
+// Enabled сообщает, задан ли хотя бы один ключ проверки токенов.
+func (c AuthConfig) Enabled() bool {
+	return c.HMACSecret != "" || c.RSAPublicKeyFile != "" || c.JWKSFile != ""
 }
 
 // Config модель основного конфига приложения.
@@ -72,6 +203,10 @@ type Config struct {
 	HTTPConfig         ServerConfig       `mapstructure:"http"`
 	MessageQueueConfig MessageQueueConfig `mapstructure:"amqp"`
 	SchedulerConfig    SchedulerConfig    `mapstructure:"scheduler"`
+	SenderConfig       SenderConfig       `mapstructure:"sender"`
+	CalendarConfig     CalendarConfig     `mapstructure:"calendar"`
+	RemindersConfig    RemindersConfig    `mapstructure:"reminders"`
+	AuthConfig         AuthConfig         `mapstructure:"auth"`
 }
 
 // NewConfig конструктор для основного конфига приложения.
@@ -0,0 +1,17 @@
+package auth
+
+import "context"
+
+type subjectKey struct{}
+
+// WithSubject возвращает контекст с идентификатором аутентифицированного пользователя.
+func WithSubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, subjectKey{}, subject)
+}
+
+// SubjectFromContext возвращает идентификатор аутентифицированного пользователя из контекста.
+func SubjectFromContext(ctx context.Context) (string, bool) {
+	subject, ok := ctx.Value(subjectKey{}).(string)
+
+	return subject, ok
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// jwk ключ из JWKS (RFC 7517). Поддерживаются ключи RSA и симметричные ключи (oct).
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	K   string `json:"k"`
+}
+
+// key ключ проверки подписи с алгоритмом и необязательным идентификатором.
+type key struct {
+	id        string
+	algorithm string
+	value     any
+}
+
+// loadRSAPublicKey читает публичный RSA ключ в формате PEM.
+func loadRSAPublicKey(path string) (*key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read RSA public key")
+	}
+
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't parse RSA public key")
+	}
+
+	return &key{algorithm: jwt.SigningMethodRS256.Alg(), value: publicKey}, nil
+}
+
+// loadJWKS читает ключи из локального JWKS файла. Ключи без alg используются с алгоритмом
+// по умолчанию для своего типа: RS256 для RSA и HS256 для oct.
+func loadJWKS(path string) ([]*key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't read JWKS")
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+
+	if err = json.Unmarshal(data, &set); err != nil {
+		return nil, errors.Wrap(err, "can't parse JWKS")
+	}
+
+	keys := make([]*key, 0, len(set.Keys))
+
+	for _, k := range set.Keys {
+		parsed, err := k.parse()
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %q", k.Kid)
+		}
+
+		keys = append(keys, parsed)
+	}
+
+	return keys, nil
+}
+
+func (k jwk) parse() (*key, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid modulus")
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid exponent")
+		}
+
+		publicKey := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+
+		return &key{id: k.Kid, algorithm: withDefault(k.Alg, jwt.SigningMethodRS256.Alg()), value: publicKey}, nil
+	case "oct":
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid secret")
+		}
+
+		return &key{id: k.Kid, algorithm: withDefault(k.Alg, jwt.SigningMethodHS256.Alg()), value: secret}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func withDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
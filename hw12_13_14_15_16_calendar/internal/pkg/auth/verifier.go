@@ -0,0 +1,111 @@
+// Package auth реализует проверку JWT, выпущенных внешним провайдером идентификации.
+package auth
+
+import (
+	"slices"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// Ошибки проверки токена.
+var (
+	ErrNoKeys       = errors.New("no verification keys configured")
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// Verifier проверяет подпись и стандартные claims JWT и возвращает subject токена.
+type Verifier struct {
+	keys   []*key
+	parser *jwt.Parser
+}
+
+// NewVerifier конструктор проверки JWT по ключам из конфига: общему секрету HS256,
+// публичному ключу RS256 и ключам из JWKS файла.
+func NewVerifier(cfg config.AuthConfig) (*Verifier, error) {
+	var keys []*key
+
+	if cfg.HMACSecret != "" {
+		keys = append(keys, &key{algorithm: jwt.SigningMethodHS256.Alg(), value: []byte(cfg.HMACSecret)})
+	}
+
+	if cfg.RSAPublicKeyFile != "" {
+		rsaKey, err := loadRSAPublicKey(cfg.RSAPublicKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "[auth::NewVerifier]")
+		}
+
+		keys = append(keys, rsaKey)
+	}
+
+	if cfg.JWKSFile != "" {
+		jwks, err := loadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "[auth::NewVerifier]")
+		}
+
+		keys = append(keys, jwks...)
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.Wrap(ErrNoKeys, "[auth::NewVerifier]")
+	}
+
+	var algorithms []string
+	for _, k := range keys {
+		if !slices.Contains(algorithms, k.algorithm) {
+			algorithms = append(algorithms, k.algorithm)
+		}
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(algorithms),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &Verifier{keys: keys, parser: jwt.NewParser(options...)}, nil
+}
+
+// Verify проверяет токен и возвращает его subject.
+func (v *Verifier) Verify(token string) (string, error) {
+	var claims jwt.RegisteredClaims
+
+	if _, err := v.parser.ParseWithClaims(token, &claims, v.keyFunc); err != nil {
+		return "", errors.Wrap(ErrInvalidToken, err.Error())
+	}
+
+	if claims.Subject == "" {
+		return "", errors.Wrap(ErrInvalidToken, "token has no subject")
+	}
+
+	return claims.Subject, nil
+}
+
+// keyFunc выбирает ключи с алгоритмом токена, а при наличии kid в заголовке - только ключ с этим kid.
+func (v *Verifier) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	var set jwt.VerificationKeySet
+
+	for _, k := range v.keys {
+		if k.algorithm == token.Method.Alg() && (kid == "" || k.id == "" || k.id == kid) {
+			set.Keys = append(set.Keys, k.value)
+		}
+	}
+
+	if len(set.Keys) == 0 {
+		return nil, errors.Errorf("no key for algorithm %q and kid %q", token.Method.Alg(), kid)
+	}
+
+	return set, nil
+}
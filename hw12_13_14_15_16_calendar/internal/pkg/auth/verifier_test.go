@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.RegisteredClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func claims(subject string, ttl time.Duration) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   subject,
+		Issuer:    "idp",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+	}
+}
+
+func TestVerifier(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "rsa-1",
+			"n":   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+		}},
+	})
+	require.NoError(t, err)
+
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, jwks, 0o600))
+
+	verifier, err := NewVerifier(config.AuthConfig{HMACSecret: "secret", JWKSFile: jwksFile, Issuer: "idp"})
+	require.NoError(t, err)
+
+	t.Run("valid tokens", func(t *testing.T) {
+		subject, err := verifier.Verify(sign(t, jwt.SigningMethodHS256, []byte("secret"), "", claims("alice", time.Hour)))
+		require.NoError(t, err)
+		require.Equal(t, "alice", subject)
+
+		subject, err = verifier.Verify(sign(t, jwt.SigningMethodRS256, privateKey, "rsa-1", claims("bob", time.Hour)))
+		require.NoError(t, err)
+		require.Equal(t, "bob", subject)
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		tokens := map[string]string{
+			"wrong secret": sign(t, jwt.SigningMethodHS256, []byte("other"), "", claims("alice", time.Hour)),
+			"expired":      sign(t, jwt.SigningMethodHS256, []byte("secret"), "", claims("alice", -time.Hour)),
+			"unknown kid":  sign(t, jwt.SigningMethodRS256, privateKey, "rsa-2", claims("bob", time.Hour)),
+			"no subject":   sign(t, jwt.SigningMethodHS256, []byte("secret"), "", claims("", time.Hour)),
+			"unsupported":  sign(t, jwt.SigningMethodHS512, []byte("secret"), "", claims("alice", time.Hour)),
+			"malformed":    "not-a-token",
+		}
+
+		for name, token := range tokens {
+			_, err := verifier.Verify(token)
+			require.ErrorIs(t, err, ErrInvalidToken, name)
+		}
+	})
+
+	_, err = NewVerifier(config.AuthConfig{})
+	require.ErrorIs(t, err, ErrNoKeys)
+}
@@ -0,0 +1,287 @@
+// Package memorymq реализует очередь сообщений в памяти процесса с семантикой подтверждений
+// RabbitMQ клиента: ручные Ack/Nack/Reject, возврат в очередь, ограничение неподтвержденных
+// сообщений (Prefetch), отложенные повторы (RetryDelays) и очередь недоставленных.
+package memorymq
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/pkg/errors"
+)
+
+// Ошибки очереди сообщений.
+var (
+	ErrClosed          = errors.New("message queue is closed")
+	ErrUnknownDelivery = errors.New("delivery is already settled")
+)
+
+// message сообщение в очереди.
+type message struct {
+	body        []byte
+	attempts    int
+	redelivered bool
+}
+
+// DeadLetter сообщение из очереди недоставленных.
+type DeadLetter struct {
+	Body     []byte
+	Attempts int
+	DeadAt   time.Time
+}
+
+// Broker очередь сообщений в памяти процесса. Безопасна для конкурентного использования
+// несколькими издателями и консьюмерами; каждое сообщение получает только один консьюмер.
+type Broker struct {
+	cfg config.MessageQueueConfig
+
+	mu     sync.Mutex
+	ready  []*message
+	dead   []*DeadLetter
+	tag    uint64
+	closed bool
+	// wake закрывается и заменяется при появлении сообщений или освобождении места под Prefetch
+	wake chan struct{}
+	done chan struct{}
+}
+
+// NewBroker конструктор очереди сообщений в памяти процесса. Из конфига используются
+// Prefetch и RetryDelays.
+func NewBroker(cfg config.MessageQueueConfig) *Broker {
+	return &Broker{
+		cfg:  cfg,
+		wake: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// Publish помещает сообщение в конец очереди.
+func (b *Broker) Publish(ctx context.Context, msg []byte) error {
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "[memorymq::Publish]")
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return errors.Wrap(ErrClosed, "[memorymq::Publish]")
+	}
+
+	b.ready = append(b.ready, &message{body: slices.Clone(msg)})
+	b.signal()
+
+	return nil
+}
+
+// Consume запускает процесс непрерывного чтения сообщений. Сообщения требуют явного подтверждения,
+// консьюмер получает не больше Prefetch неподтвержденных сообщений (0 - без ограничения).
+// Канал закрывается при отмене ctx или закрытии очереди; неподтвержденные к этому моменту
+// сообщения возвращаются в очередь.
+func (b *Broker) Consume(ctx context.Context) (<-chan *Delivery, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, errors.Wrap(ErrClosed, "[memorymq::Consume]")
+	}
+
+	c := &consumer{broker: b, unacked: make(map[uint64]*message)}
+	out := make(chan *Delivery)
+
+	go c.dispatch(ctx, out)
+
+	return out, nil
+}
+
+// DeadLetters возвращает сообщения из очереди недоставленных.
+func (b *Broker) DeadLetters() []*DeadLetter {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	result := make([]*DeadLetter, 0, len(b.dead))
+	for _, dead := range b.dead {
+		copied := *dead
+		result = append(result, &copied)
+	}
+
+	return result
+}
+
+// Close закрывает очередь: публикации завершаются ошибкой ErrClosed, каналы консьюмеров закрываются.
+// Повторный вызов ничего не делает.
+func (b *Broker) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.closed {
+		b.closed = true
+		close(b.done)
+	}
+
+	return nil
+}
+
+// signal будит консьюмеров, ожидающих сообщений. Вызывается под mu.
+func (b *Broker) signal() {
+	close(b.wake)
+	b.wake = make(chan struct{})
+}
+
+// requeue возвращает сообщения в начало очереди для повторной доставки, сохраняя их порядок.
+// Вызывается под mu.
+func (b *Broker) requeue(msgs []*message) {
+	for _, msg := range msgs {
+		msg.redelivered = true
+	}
+
+	b.ready = append(slices.Clone(msgs), b.ready...)
+	b.signal()
+}
+
+// deadLetter перемещает сообщения в очередь недоставленных. Вызывается под mu.
+func (b *Broker) deadLetter(msgs []*message) {
+	now := time.Now().UTC()
+
+	for _, msg := range msgs {
+		b.dead = append(b.dead, &DeadLetter{
+			Body:     msg.body,
+			Attempts: msg.attempts,
+			DeadAt:   now,
+		})
+	}
+}
+
+// retryLater возвращает сообщение в конец очереди по истечении delay, если очередь еще открыта.
+func (b *Broker) retryLater(msg *message, delay time.Duration) {
+	time.AfterFunc(delay, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if b.closed {
+			return
+		}
+
+		msg.redelivered = true
+		b.ready = append(b.ready, msg)
+		b.signal()
+	})
+}
+
+// consumer подписка на очередь с собственным набором неподтвержденных сообщений.
+type consumer struct {
+	broker  *Broker
+	unacked map[uint64]*message
+}
+
+// dispatch передает сообщения консьюмеру, соблюдая Prefetch.
+func (c *consumer) dispatch(ctx context.Context, out chan<- *Delivery) {
+	b := c.broker
+
+	defer close(out)
+	defer c.cancel()
+
+	for {
+		b.mu.Lock()
+
+		if b.closed {
+			b.mu.Unlock()
+			return
+		}
+
+		if len(b.ready) == 0 || (b.cfg.Prefetch > 0 && len(c.unacked) >= b.cfg.Prefetch) {
+			wake := b.wake
+			b.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-b.done:
+				return
+			case <-wake:
+			}
+
+			continue
+		}
+
+		msg := b.ready[0]
+		b.ready = b.ready[1:]
+		b.tag++
+		c.unacked[b.tag] = msg
+
+		delivery := &Delivery{
+			Body:        msg.body,
+			Attempts:    msg.attempts,
+			Redelivered: msg.redelivered,
+			tag:         b.tag,
+			consumer:    c,
+		}
+
+		b.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-b.done:
+			return
+		case out <- delivery:
+		}
+	}
+}
+
+// cancel возвращает неподтвержденные сообщения отписавшегося консьюмера в очередь.
+func (c *consumer) cancel() {
+	b := c.broker
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	msgs := c.take(func(uint64) bool { return true })
+	if !b.closed && len(msgs) > 0 {
+		b.requeue(msgs)
+	}
+}
+
+// settle снимает с консьюмера сообщение tag (и все предыдущие при multiple) и применяет к ним action.
+func (c *consumer) settle(tag uint64, multiple bool, action func(msgs []*message)) error {
+	b := c.broker
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := c.unacked[tag]; !ok {
+		return ErrUnknownDelivery
+	}
+
+	action(c.take(func(t uint64) bool {
+		return t == tag || (multiple && t < tag)
+	}))
+
+	// освободилось место под Prefetch
+	b.signal()
+
+	return nil
+}
+
+// take снимает с консьюмера неподтвержденные сообщения, теги которых удовлетворяют match,
+// и возвращает их в порядке доставки. Вызывается под mu.
+func (c *consumer) take(match func(tag uint64) bool) []*message {
+	tags := make([]uint64, 0, len(c.unacked))
+	for tag := range c.unacked {
+		if match(tag) {
+			tags = append(tags, tag)
+		}
+	}
+	slices.Sort(tags)
+
+	msgs := make([]*message, 0, len(tags))
+	for _, tag := range tags {
+		msgs = append(msgs, c.unacked[tag])
+		delete(c.unacked, tag)
+	}
+
+	return msgs
+}
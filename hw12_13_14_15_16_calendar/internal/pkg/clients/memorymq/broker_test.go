@@ -0,0 +1,148 @@
+package memorymq
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func receive(t *testing.T, msgs <-chan *Delivery) *Delivery {
+	t.Helper()
+
+	select {
+	case msg, ok := <-msgs:
+		require.True(t, ok, "consumer channel closed")
+		return msg
+	case <-time.After(time.Second):
+		require.FailNow(t, "no message received")
+		return nil
+	}
+}
+
+func requireEmpty(t *testing.T, msgs <-chan *Delivery) {
+	t.Helper()
+
+	select {
+	case msg := <-msgs:
+		require.FailNow(t, "unexpected message", "%s", msg.Body)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBroker(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ack and requeue", func(t *testing.T) {
+		broker := NewBroker(config.MessageQueueConfig{})
+		defer broker.Close()
+
+		msgs, err := broker.Consume(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, broker.Publish(ctx, []byte("first")))
+		require.NoError(t, broker.Publish(ctx, []byte("second")))
+
+		first := receive(t, msgs)
+		require.Equal(t, "first", string(first.GetBody()))
+		require.NoError(t, first.Nack(false, true))
+		require.ErrorIs(t, first.Ack(false), ErrUnknownDelivery)
+
+		second := receive(t, msgs)
+		require.Equal(t, "second", string(second.GetBody()))
+		require.NoError(t, second.Ack(false))
+
+		redelivered := receive(t, msgs)
+		require.Equal(t, "first", string(redelivered.GetBody()))
+		require.True(t, redelivered.Redelivered)
+		require.NoError(t, redelivered.Ack(false))
+
+		requireEmpty(t, msgs)
+		require.Empty(t, broker.DeadLetters())
+	})
+
+	t.Run("prefetch limits unacked deliveries", func(t *testing.T) {
+		broker := NewBroker(config.MessageQueueConfig{Prefetch: 2})
+		defer broker.Close()
+
+		msgs, err := broker.Consume(ctx)
+		require.NoError(t, err)
+
+		for _, body := range []string{"a", "b", "c"} {
+			require.NoError(t, broker.Publish(ctx, []byte(body)))
+		}
+
+		receive(t, msgs)
+		second := receive(t, msgs)
+		requireEmpty(t, msgs)
+
+		require.NoError(t, second.Ack(true))
+		require.Equal(t, "c", string(receive(t, msgs).GetBody()))
+	})
+
+	t.Run("reject and exhausted retries go to dead letters", func(t *testing.T) {
+		broker := NewBroker(config.MessageQueueConfig{RetryDelays: []time.Duration{time.Millisecond}})
+		defer broker.Close()
+
+		msgs, err := broker.Consume(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, broker.Publish(ctx, []byte("malformed")))
+		require.NoError(t, receive(t, msgs).Reject(false))
+
+		require.NoError(t, broker.Publish(ctx, []byte("failing")))
+
+		failing := receive(t, msgs)
+		require.NoError(t, failing.Retry(ctx))
+
+		retried := receive(t, msgs)
+		require.Equal(t, "failing", string(retried.GetBody()))
+		require.Equal(t, 1, retried.Attempts)
+		require.NoError(t, retried.Retry(ctx))
+
+		dead := broker.DeadLetters()
+		require.Len(t, dead, 2)
+		require.Equal(t, "malformed", string(dead[0].Body))
+		require.Equal(t, "failing", string(dead[1].Body))
+		require.Equal(t, 2, dead[1].Attempts)
+	})
+
+	t.Run("cancelled consumer returns unacked deliveries", func(t *testing.T) {
+		broker := NewBroker(config.MessageQueueConfig{})
+		defer broker.Close()
+
+		consumeCtx, cancel := context.WithCancel(ctx)
+
+		msgs, err := broker.Consume(consumeCtx)
+		require.NoError(t, err)
+
+		require.NoError(t, broker.Publish(ctx, []byte("unacked")))
+		unacked := receive(t, msgs)
+
+		cancel()
+		_, ok := <-msgs
+		require.False(t, ok)
+
+		require.ErrorIs(t, unacked.Ack(false), ErrUnknownDelivery)
+
+		msgs, err = broker.Consume(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "unacked", string(receive(t, msgs).GetBody()))
+	})
+
+	t.Run("closed broker", func(t *testing.T) {
+		broker := NewBroker(config.MessageQueueConfig{})
+
+		msgs, err := broker.Consume(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, broker.Close())
+		require.NoError(t, broker.Close())
+
+		_, ok := <-msgs
+		require.False(t, ok)
+		require.ErrorIs(t, broker.Publish(ctx, []byte("late")), ErrClosed)
+	})
+}
@@ -0,0 +1,71 @@
+package memorymq
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Delivery сообщение, полученное консьюмером.
+type Delivery struct {
+	Body []byte
+	// Attempts количество неудачных попыток обработки, отложенных через Retry
+	Attempts    int
+	Redelivered bool
+
+	tag      uint64
+	consumer *consumer
+}
+
+// GetBody возвращает тело сообщения.
+func (d *Delivery) GetBody() []byte {
+	return d.Body
+}
+
+// Ack подтверждает обработку сообщения (и всех предыдущих неподтвержденных при multiple).
+func (d *Delivery) Ack(multiple bool) error {
+	err := d.consumer.settle(d.tag, multiple, func([]*message) {})
+	return errors.Wrap(err, "[memorymq::Ack]")
+}
+
+// Nack отклоняет сообщение (и все предыдущие неподтвержденные при multiple). При requeue сообщение
+// возвращается в начало очереди, иначе попадает в очередь недоставленных.
+func (d *Delivery) Nack(multiple bool, requeue bool) error {
+	b := d.consumer.broker
+
+	err := d.consumer.settle(d.tag, multiple, func(msgs []*message) {
+		if requeue {
+			b.requeue(msgs)
+			return
+		}
+		b.deadLetter(msgs)
+	})
+
+	return errors.Wrap(err, "[memorymq::Nack]")
+}
+
+// Reject отклоняет сообщение, см. Nack.
+func (d *Delivery) Reject(requeue bool) error {
+	return d.Nack(false, requeue)
+}
+
+// Retry откладывает сообщение, обработка которого не удалась, на задержку из RetryDelays
+// следующего уровня, увеличивая счетчик попыток. После len(RetryDelays) попыток сообщение
+// попадает в очередь недоставленных.
+func (d *Delivery) Retry(_ context.Context) error {
+	b := d.consumer.broker
+
+	err := d.consumer.settle(d.tag, false, func(msgs []*message) {
+		msg := msgs[0]
+		msg.attempts++
+
+		if msg.attempts > len(b.cfg.RetryDelays) {
+			b.deadLetter(msgs)
+			return
+		}
+
+		b.retryLater(msg, b.cfg.RetryDelays[msg.attempts-1])
+	})
+
+	return errors.Wrap(err, "[memorymq::Retry]")
+}
@@ -0,0 +1,181 @@
+// Package natsjs реализует транспорт уведомлений поверх NATS JetStream: сообщения хранятся
+// в потоке с политикой work queue, а читаются durable консьюмером с явным подтверждением.
+package natsjs
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/pkg/errors"
+)
+
+// HeaderAttempts заголовок сообщения из потока недоставленных с количеством попыток обработки.
+const HeaderAttempts = "X-Attempts"
+
+// setupTimeout ограничивает объявление потоков при создании клиента.
+const setupTimeout = 10 * time.Second
+
+// Client основной NATS JetStream клиент. Переподключение к серверу выполняет сам nats.Conn,
+// durable консьюмер продолжает чтение с места остановки.
+type Client struct {
+	conn   *nats.Conn
+	js     jetstream.JetStream
+	stream jetstream.Stream
+	cfg    config.MessageQueueConfig
+}
+
+// NewClient конструктор NATS JetStream клиента, объявляющий поток Queue с одноименным субъектом
+// и поток недоставленных Queue_dlq с субъектом Queue.dlq. Durable потоки хранятся на диске сервера,
+// иначе в памяти.
+func NewClient(cfg config.MessageQueueConfig) (*Client, error) {
+	conn, err := nats.Connect(cfg.URL, nats.MaxReconnects(-1))
+	if err != nil {
+		return nil, errors.Wrap(err, "[natsjs::NewClient]: failed to connect to nats")
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "[natsjs::NewClient]: failed to create jetstream context")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), setupTimeout)
+	defer cancel()
+
+	storage := jetstream.MemoryStorage
+	if cfg.Durable {
+		storage = jetstream.FileStorage
+	}
+
+	stream, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      cfg.Queue,
+		Subjects:  []string{cfg.Queue},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   storage,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "[natsjs::NewClient]: failed to declare stream %q", cfg.Queue)
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     deadLetterStream(cfg.Queue),
+		Subjects: []string{deadLetterSubject(cfg.Queue)},
+		Storage:  storage,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "[natsjs::NewClient]: failed to declare stream %q", deadLetterStream(cfg.Queue))
+	}
+
+	return &Client{
+		conn:   conn,
+		js:     js,
+		stream: stream,
+		cfg:    cfg,
+	}, nil
+}
+
+// Close закрывает соединение с NATS сервером.
+func (c *Client) Close() error {
+	c.conn.Close()
+	return nil
+}
+
+// Publish публикует сообщение в поток и дожидается подтверждения от сервера, но не дольше PublishTimeout.
+// Идентификатор сообщения позволяет серверу отбросить дубликат при повторной публикации.
+func (c *Client) Publish(ctx context.Context, msg []byte) error {
+	if c.cfg.PublishTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.PublishTimeout)
+		defer cancel()
+	}
+
+	_, err := c.js.Publish(ctx, c.cfg.Queue, msg, jetstream.WithMsgID(uuid.New().String()))
+	return errors.Wrap(err, "[natsjs::Publish]: failed to publish nats message")
+}
+
+// Consume запускает процесс непрерывного чтения сообщений durable консьюмером Queue-sender.
+// Сообщения требуют явного подтверждения, сервер передает консьюмеру не больше Prefetch
+// неподтвержденных сообщений (0 - значение сервера по умолчанию). Канал закрывается при отмене ctx
+// или закрытии клиента.
+func (c *Client) Consume(ctx context.Context) (<-chan *Delivery, error) {
+	consumer, err := c.stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable:       consumerName(c.cfg.Queue),
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		MaxAckPending: c.cfg.Prefetch,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "[natsjs::Consume]: failed to declare consumer")
+	}
+
+	var opts []jetstream.PullMessagesOpt
+	if c.cfg.Prefetch > 0 {
+		opts = append(opts, jetstream.PullMaxMessages(c.cfg.Prefetch))
+	}
+
+	iter, err := consumer.Messages(opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "[natsjs::Consume]: failed to receive messages from nats")
+	}
+
+	msgs := make(chan *Delivery)
+
+	go func() {
+		<-ctx.Done()
+		iter.Stop()
+	}()
+
+	go func() {
+		defer close(msgs)
+
+		for {
+			msg, inErr := iter.Next()
+			if inErr != nil {
+				// итератор закрыт отменой ctx или закрытием соединения
+				return
+			}
+
+			select {
+			case msgs <- &Delivery{msg: msg, client: c}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return msgs, nil
+}
+
+// deadLetter публикует сообщение в поток недоставленных с количеством попыток обработки.
+func (c *Client) deadLetter(ctx context.Context, data []byte, attempts int) error {
+	if c.cfg.PublishTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.PublishTimeout)
+		defer cancel()
+	}
+
+	msg := nats.NewMsg(deadLetterSubject(c.cfg.Queue))
+	msg.Data = data
+	msg.Header.Set(HeaderAttempts, strconv.Itoa(attempts))
+
+	_, err := c.js.PublishMsg(ctx, msg)
+	return errors.Wrap(err, "failed to publish nats message to dead letter stream")
+}
+
+func consumerName(queue string) string {
+	return queue + "-sender"
+}
+
+func deadLetterStream(queue string) string {
+	return queue + "_dlq"
+}
+
+func deadLetterSubject(queue string) string {
+	return queue + ".dlq"
+}
@@ -0,0 +1,146 @@
+package natsjs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/stretchr/testify/require"
+)
+
+func runServer(t *testing.T) string {
+	t.Helper()
+
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	require.NoError(t, err)
+
+	srv.Start()
+	t.Cleanup(srv.Shutdown)
+
+	require.True(t, srv.ReadyForConnections(5*time.Second), "nats server is not ready")
+
+	return srv.ClientURL()
+}
+
+func receive(t *testing.T, msgs <-chan *Delivery) *Delivery {
+	t.Helper()
+
+	select {
+	case msg, ok := <-msgs:
+		require.True(t, ok, "consumer channel closed")
+		return msg
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no message received")
+		return nil
+	}
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := config.MessageQueueConfig{
+		URL:            runServer(t),
+		Queue:          "events",
+		Durable:        true,
+		Prefetch:       10,
+		RetryDelays:    []time.Duration{10 * time.Millisecond},
+		PublishTimeout: 5 * time.Second,
+	}
+
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+	defer client.Close()
+
+	t.Run("durable consumer resumes after restart", func(t *testing.T) {
+		consumeCtx, cancel := context.WithCancel(ctx)
+
+		msgs, err := client.Consume(consumeCtx)
+		require.NoError(t, err)
+
+		require.NoError(t, client.Publish(ctx, []byte("first")))
+
+		first := receive(t, msgs)
+		require.Equal(t, "first", string(first.GetBody()))
+		require.NoError(t, first.Ack(false))
+
+		cancel()
+
+		// сообщение, опубликованное без активного консьюмера, дождется его в потоке
+		require.NoError(t, client.Publish(ctx, []byte("second")))
+
+		consumeCtx, cancel = context.WithCancel(ctx)
+		defer cancel()
+
+		msgs, err = client.Consume(consumeCtx)
+		require.NoError(t, err)
+
+		second := receive(t, msgs)
+		require.Equal(t, "second", string(second.GetBody()))
+		require.NoError(t, second.Ack(false))
+	})
+
+	t.Run("nack requeues and exhausted retries go to dead letters", func(t *testing.T) {
+		consumeCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		msgs, err := client.Consume(consumeCtx)
+		require.NoError(t, err)
+
+		require.NoError(t, client.Publish(ctx, []byte("failing")))
+
+		msg := receive(t, msgs)
+		require.NoError(t, msg.Nack(false, true))
+
+		// вторая доставка исчерпывает единственный повтор
+		msg = receive(t, msgs)
+		require.Equal(t, "failing", string(msg.GetBody()))
+		require.NoError(t, msg.Retry(ctx))
+
+		require.NoError(t, client.Publish(ctx, []byte("malformed")))
+		require.NoError(t, receive(t, msgs).Reject(false))
+
+		dlq, err := client.js.Stream(ctx, deadLetterStream(cfg.Queue))
+		require.NoError(t, err)
+
+		dead, err := dlq.GetMsg(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, "failing", string(dead.Data))
+		require.Equal(t, "2", dead.Header.Get(HeaderAttempts))
+
+		dead, err = dlq.GetMsg(ctx, 2)
+		require.NoError(t, err)
+		require.Equal(t, "malformed", string(dead.Data))
+	})
+
+	t.Run("retry redelivers after delay", func(t *testing.T) {
+		consumeCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		msgs, err := client.Consume(consumeCtx)
+		require.NoError(t, err)
+
+		require.NoError(t, client.Publish(ctx, []byte("flaky")))
+
+		msg := receive(t, msgs)
+		require.NoError(t, msg.Retry(ctx))
+
+		msg = receive(t, msgs)
+		require.Equal(t, "flaky", string(msg.GetBody()))
+		require.NoError(t, msg.Ack(false))
+
+		// work queue поток удаляет подтвержденные сообщения
+		require.Eventually(t, func() bool {
+			info, err := client.stream.Info(ctx)
+			return err == nil && info.State.Msgs == 0
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
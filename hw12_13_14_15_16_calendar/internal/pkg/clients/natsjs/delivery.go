@@ -0,0 +1,81 @@
+package natsjs
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/pkg/errors"
+)
+
+// Delivery сообщение, полученное durable консьюмером. JetStream подтверждает сообщения
+// по одному, поэтому флаг multiple игнорируется.
+type Delivery struct {
+	msg    jetstream.Msg
+	client *Client
+}
+
+// GetBody возвращает тело сообщения.
+func (d *Delivery) GetBody() []byte {
+	return d.msg.Data()
+}
+
+// Ack подтверждает обработку сообщения, после чего сервер удаляет его из потока.
+func (d *Delivery) Ack(_ bool) error {
+	return errors.Wrap(d.msg.Ack(), "[natsjs::Ack]: failed to ack nats message")
+}
+
+// Nack отклоняет сообщение. При requeue сервер доставит его повторно,
+// иначе сообщение перемещается в поток недоставленных.
+func (d *Delivery) Nack(_ bool, requeue bool) error {
+	if requeue {
+		return errors.Wrap(d.msg.Nak(), "[natsjs::Nack]: failed to nak nats message")
+	}
+
+	return errors.Wrap(d.terminate(context.Background()), "[natsjs::Nack]")
+}
+
+// Reject отклоняет сообщение, см. Nack.
+func (d *Delivery) Reject(requeue bool) error {
+	return d.Nack(false, requeue)
+}
+
+// Retry просит сервер доставить сообщение повторно через задержку из RetryDelays, соответствующую
+// номеру доставки. После len(RetryDelays) повторов сообщение перемещается в поток недоставленных.
+func (d *Delivery) Retry(ctx context.Context) error {
+	attempts, err := d.attempts()
+	if err != nil {
+		return errors.Wrap(err, "[natsjs::Retry]")
+	}
+
+	delays := d.client.cfg.RetryDelays
+	if attempts > len(delays) {
+		return errors.Wrap(d.terminate(ctx), "[natsjs::Retry]")
+	}
+
+	err = d.msg.NakWithDelay(delays[attempts-1])
+	return errors.Wrap(err, "[natsjs::Retry]: failed to nak nats message")
+}
+
+// attempts возвращает номер доставки сообщения, начиная с 1.
+func (d *Delivery) attempts() (int, error) {
+	meta, err := d.msg.Metadata()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read nats message metadata")
+	}
+
+	return int(meta.NumDelivered), nil //nolint:gosec
+}
+
+// terminate перемещает сообщение в поток недоставленных и прекращает его доставку.
+func (d *Delivery) terminate(ctx context.Context) error {
+	attempts, err := d.attempts()
+	if err != nil {
+		return err
+	}
+
+	if err = d.client.deadLetter(ctx, d.msg.Data(), attempts); err != nil {
+		return err
+	}
+
+	return errors.Wrap(d.msg.Term(), "failed to terminate nats message")
+}
@@ -2,64 +2,284 @@ package rabbitmq
 
 import (
 	"context"
+	"sync"
 
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
-// Client основной RabbitMQ клиент.
+// Ошибки публикации сообщений.
+var (
+	ErrNacked       = errors.New("amqp message was nacked by broker")
+	ErrUnroutable   = errors.New("amqp message is unroutable")
+	ErrDisconnected = errors.New("amqp connection is not available")
+)
+
+// returnsBuffer размер буфера для возвращенных брокером сообщений.
+const returnsBuffer = 16
+
+// Client основной RabbitMQ клиент. При разрыве соединения клиент переподключается к брокеру
+// (см. supervise), а на время переподключения публикации завершаются ошибкой ErrDisconnected.
 type Client struct {
-	conn    *amqp.Connection
-	channel *amqp.Channel
-	queue   string
+	cfg config.MessageQueueConfig
+
+	// mu защищает текущее соединение и канал, которые заменяются при переподключении
+	mu          sync.RWMutex
+	conn        *amqp.Connection
+	channel     *amqp.Channel
+	returns     chan amqp.Return
+	closed      chan *amqp.Error
+	reconnected chan struct{}
+
+	// publishMu сериализует публикации, чтобы возвращенное сообщение относилось к текущей публикации
+	publishMu sync.Mutex
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewClient конструктор RabbitMQ клиента, объявляющий топологию очередей (см. declareTopology).
+// Durable очереди переживают перезапуск брокера, сообщения в них публикуются с persistent доставкой;
+// иначе основная очередь удаляется после отключения последнего консьюмера. Объявление уже существующей
+// очереди с другими параметрами завершится ошибкой.
+func NewClient(cfg config.MessageQueueConfig) (*Client, error) {
+	c := &Client{
+		cfg:         cfg,
+		reconnected: make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+
+	if err := c.connect(); err != nil {
+		return nil, errors.Wrap(err, "[rabbitmq::NewClient]")
+	}
+
+	go c.supervise()
+
+	return c, nil
 }
 
-// NewClient конструктор RabbitMQ клиента.
-func NewClient(url, queue string) (*Client, error) {
-	conn, err := amqp.Dial(url)
+// connect устанавливает соединение с брокером, открывает канал в режиме подтверждений
+// и объявляет топологию очередей.
+func (c *Client) connect() error {
+	conn, err := amqp.Dial(c.cfg.URL)
 	if err != nil {
-		return nil, errors.Wrap(err, "[rabbitmq::NewClient]: failed to establish amqp connection")
+		return errors.Wrap(err, "failed to establish amqp connection")
 	}
 
 	channel, err := conn.Channel()
 	if err != nil {
-		return nil, errors.Wrap(err, "[rabbitmq::NewClient]: failed to open amqp channel")
+		_ = conn.Close()
+		return errors.Wrap(err, "failed to open amqp channel")
 	}
 
-	if _, err = channel.QueueDeclare(queue, false, false, true, false, nil); err != nil {
-		return nil, errors.Wrapf(err, "[rabbitmq::NewClient]: failed to declare amqp queue %q", queue)
+	if err = channel.Confirm(false); err != nil {
+		_ = conn.Close()
+		return errors.Wrap(err, "failed to put amqp channel into confirm mode")
+	}
+
+	if err = declareTopology(channel, c.cfg); err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.conn = conn
+	c.channel = channel
+	c.returns = channel.NotifyReturn(make(chan amqp.Return, returnsBuffer))
+	// закрытие соединения закрывает и канал, поэтому достаточно следить за каналом
+	c.closed = channel.NotifyClose(make(chan *amqp.Error, 1))
+
+	return nil
+}
+
+// current возвращает текущий канал и канал возвращенных сообщений.
+func (c *Client) current() (*amqp.Channel, chan amqp.Return, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.channel.IsClosed() {
+		return nil, nil, ErrDisconnected
 	}
 
-	return &Client{
-		conn:    conn,
-		channel: channel,
-		queue:   queue,
-	}, nil
+	return c.channel, c.returns, nil
 }
 
-// Close закрывает соединение с RabbitMQ сервером.
+// Close останавливает переподключение и закрывает соединение с RabbitMQ сервером.
 func (c *Client) Close() error {
+	c.closeOnce.Do(func() { close(c.done) })
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.conn.IsClosed() {
+		return nil
+	}
+
 	err := c.conn.Close()
 	return errors.Wrap(err, "[rabbitmq::Close]: failed to close amqp connection")
 }
 
-// Publish публикует сообщение в очередь.
+// Publish публикует сообщение в очередь и дожидается подтверждения от брокера.
 func (c *Client) Publish(ctx context.Context, msg []byte) error {
-	err := c.channel.PublishWithContext(ctx,
-		"",
-		c.queue,
-		false,
-		false,
-		amqp.Publishing{
-			ContentType: "application/json",
-			Body:        msg,
-		})
-
-	return errors.Wrap(err, "[rabbitmq::Publish]: failed to publish amqp message")
+	err := c.publish(ctx, c.cfg.Queue, amqp.Publishing{
+		ContentType: "application/json",
+		Body:        msg,
+	})
+
+	return errors.Wrap(err, "[rabbitmq::Publish]")
+}
+
+// publish публикует сообщение в очередь routingKey через exchange по умолчанию с флагом mandatory
+// и дожидается подтверждения от брокера, но не дольше PublishTimeout.
+//
+// Брокер отправляет basic.return раньше подтверждения того же сообщения, поэтому к моменту
+// подтверждения возвращенное сообщение уже лежит в буфере returns. Буфер вычитывается при любом
+// исходе публикации: иначе в нем копились бы возвраты неподтвержденных публикаций, а заполненный
+// буфер блокирует чтение соединения и вместе с ним все последующие публикации.
+func (c *Client) publish(ctx context.Context, routingKey string, msg amqp.Publishing) error {
+	if c.cfg.PublishTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.PublishTimeout)
+		defer cancel()
+	}
+
+	msg.MessageId = uuid.New().String()
+	msg.DeliveryMode = amqp.Transient
+	if c.cfg.Durable {
+		msg.DeliveryMode = amqp.Persistent
+	}
+
+	c.publishMu.Lock()
+	defer c.publishMu.Unlock()
+
+	channel, returns, err := c.current()
+	if err != nil {
+		return err
+	}
+
+	err = publishConfirmed(ctx, channel, routingKey, msg)
+
+	if ret := drainReturns(returns, msg.MessageId); ret != nil && err == nil {
+		return errors.Wrapf(ErrUnroutable, "%s (%d %s)", routingKey, ret.ReplyCode, ret.ReplyText)
+	}
+
+	return err
+}
+
+// publishConfirmed публикует сообщение в канал и дожидается его подтверждения. Закрытие канала
+// до подтверждения тоже разрешает его отказом, поэтому такой отказ считается разрывом соединения.
+func publishConfirmed(ctx context.Context, channel *amqp.Channel, routingKey string, msg amqp.Publishing) error {
+	confirmation, err := channel.PublishWithDeferredConfirmWithContext(ctx, "", routingKey, true, false, msg)
+	if err != nil {
+		if errors.Is(err, amqp.ErrClosed) {
+			return ErrDisconnected
+		}
+		return errors.Wrap(err, "failed to publish amqp message")
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to wait for publish confirmation")
+	}
+
+	if !acked {
+		if channel.IsClosed() {
+			return ErrDisconnected
+		}
+		return ErrNacked
+	}
+
+	return nil
+}
+
+// drainReturns вычитывает накопившиеся в буфере возвращенные сообщения и возвращает среди них
+// сообщение messageID. Буфер закрытого канала считается пустым.
+func drainReturns(returns <-chan amqp.Return, messageID string) *amqp.Return {
+	var found *amqp.Return
+
+	for {
+		select {
+		case ret, ok := <-returns:
+			if !ok {
+				return found
+			}
+
+			if ret.MessageId == messageID {
+				found = &ret
+			}
+		default:
+			return found
+		}
+	}
 }
 
 // Consume запускает процесс непрерывного чтения опубликованных сообщений.
+// Сообщения требуют явного подтверждения (Ack/Nack/Reject), брокер передает консьюмеру
+// не больше Prefetch неподтвержденных сообщений (0 - без ограничения).
+//
+// Возвращаемый канал переживает переподключения к брокеру: после восстановления соединения
+// чтение возобновляется в тот же канал. Канал закрывается при отмене ctx или закрытии клиента.
+// Подтверждение сообщений, полученных до разрыва соединения, завершится ошибкой,
+// а сами сообщения брокер доставит повторно.
 func (c *Client) Consume(ctx context.Context) (<-chan amqp.Delivery, error) {
-	msgs, err := c.channel.ConsumeWithContext(ctx, c.queue, "", true, false, false, false, nil)
-	return msgs, errors.Wrap(err, "[rabbitmq::Consume]: failed to receive messages from amqp")
+	reconnected := c.reconnectedSignal()
+
+	msgs, err := c.consume(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[rabbitmq::Consume]")
+	}
+
+	out := make(chan amqp.Delivery)
+
+	go func() {
+		defer close(out)
+
+		for {
+			for msg := range msgs {
+				select {
+				case out <- msg:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			// канал сообщений закрывается при отмене ctx, закрытии клиента или разрыве соединения
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-c.done:
+					return
+				case <-reconnected:
+				}
+
+				reconnected = c.reconnectedSignal()
+
+				if msgs, err = c.consume(ctx); err == nil {
+					break
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// consume подписывается на основную очередь в текущем канале.
+func (c *Client) consume(ctx context.Context) (<-chan amqp.Delivery, error) {
+	channel, _, err := c.current()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = channel.Qos(c.cfg.Prefetch, 0, false); err != nil {
+		return nil, errors.Wrap(err, "failed to set amqp prefetch")
+	}
+
+	msgs, err := channel.ConsumeWithContext(ctx, c.cfg.Queue, "", false, false, false, false, nil)
+	return msgs, errors.Wrap(err, "failed to receive messages from amqp")
 }
@@ -0,0 +1,38 @@
+package rabbitmq
+
+import (
+	"testing"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainReturns(t *testing.T) {
+	t.Run("drains stale returns and finds own message", func(t *testing.T) {
+		returns := make(chan amqp.Return, returnsBuffer)
+		returns <- amqp.Return{MessageId: "stale"}
+		returns <- amqp.Return{MessageId: "current", ReplyCode: amqp.NoRoute}
+
+		ret := drainReturns(returns, "current")
+		require.NotNil(t, ret)
+		require.Equal(t, uint16(amqp.NoRoute), ret.ReplyCode)
+		require.Empty(t, returns)
+	})
+
+	t.Run("drains returns of failed publishes", func(t *testing.T) {
+		returns := make(chan amqp.Return, returnsBuffer)
+		for range returnsBuffer {
+			returns <- amqp.Return{MessageId: "timed-out"}
+		}
+
+		require.Nil(t, drainReturns(returns, "current"))
+		require.Empty(t, returns)
+	})
+
+	t.Run("closed channel", func(t *testing.T) {
+		returns := make(chan amqp.Return, returnsBuffer)
+		close(returns)
+
+		require.Nil(t, drainReturns(returns, "current"))
+	})
+}
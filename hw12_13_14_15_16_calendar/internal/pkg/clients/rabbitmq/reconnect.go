@@ -0,0 +1,110 @@
+package rabbitmq
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Пауза между попытками переподключения, если она не задана в конфиге.
+const (
+	defaultReconnectBackoff    = 500 * time.Millisecond
+	defaultReconnectMaxBackoff = 30 * time.Second
+)
+
+// supervise следит за каналом клиента и при его закрытии (рестарт брокера, разрыв сети,
+// канальная ошибка) переподключается к брокеру с экспоненциальной паузой между попытками,
+// заново объявляя топологию. Успешное переподключение сигнализируется закрытием reconnected,
+// по которому консьюмеры возобновляют чтение. Завершается при закрытии клиента.
+func (c *Client) supervise() {
+	for {
+		c.mu.RLock()
+		closed := c.closed
+		c.mu.RUnlock()
+
+		select {
+		case <-c.done:
+			return
+		case amqpErr := <-closed:
+			if c.isDone() {
+				return
+			}
+
+			log.Warn().Err(amqpErr).Msg("[rabbitmq::supervise]: amqp channel closed, reconnecting")
+		}
+
+		// закрываем соединение, если закрылся только канал
+		c.mu.RLock()
+		_ = c.conn.Close()
+		c.mu.RUnlock()
+
+		if !c.reconnect() {
+			return
+		}
+
+		log.Info().Msg("[rabbitmq::supervise]: amqp connection restored")
+
+		c.mu.Lock()
+		close(c.reconnected)
+		c.reconnected = make(chan struct{})
+		c.mu.Unlock()
+	}
+}
+
+// reconnect пытается восстановить соединение, пока это не удастся или клиент не будет закрыт.
+// Возвращает false, если клиент закрыт.
+func (c *Client) reconnect() bool {
+	backoff := c.cfg.ReconnectBackoff
+	if backoff <= 0 {
+		backoff = defaultReconnectBackoff
+	}
+
+	maxBackoff := c.cfg.ReconnectMaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultReconnectMaxBackoff
+	}
+
+	for {
+		timer := time.NewTimer(backoff)
+
+		select {
+		case <-c.done:
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+
+		err := c.connect()
+		if err == nil {
+			if c.isDone() {
+				c.mu.RLock()
+				_ = c.conn.Close()
+				c.mu.RUnlock()
+				return false
+			}
+			return true
+		}
+
+		log.Error().Err(err).Dur("backoff", backoff).Msg("[rabbitmq::reconnect]: failed to reconnect")
+
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// reconnectedSignal возвращает канал, который будет закрыт после ближайшего переподключения.
+func (c *Client) reconnectedSignal() <-chan struct{} {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.reconnected
+}
+
+// isDone сообщает, закрыт ли клиент.
+func (c *Client) isDone() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
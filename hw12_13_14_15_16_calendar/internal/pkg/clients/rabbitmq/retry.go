@@ -0,0 +1,167 @@
+package rabbitmq
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// HeaderAttempts заголовок с количеством неудачных попыток обработки сообщения.
+const HeaderAttempts = "x-attempts"
+
+// Attempts возвращает количество неудачных попыток обработки сообщения.
+func Attempts(headers amqp.Table) int {
+	switch v := headers[HeaderAttempts].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
+
+// Retry откладывает сообщение, обработка которого не удалась, в очередь повторов
+// следующего уровня, увеличивая счетчик попыток. После len(RetryDelays) попыток сообщение
+// отклоняется и попадает в очередь недоставленных через dead-letter exchange.
+func (c *Client) Retry(ctx context.Context, delivery amqp.Delivery) error {
+	attempts := Attempts(delivery.Headers) + 1
+
+	if attempts > len(c.cfg.RetryDelays) {
+		err := delivery.Reject(false)
+		return errors.Wrap(err, "[rabbitmq::Retry]: failed to dead-letter amqp message")
+	}
+
+	headers := make(amqp.Table, len(delivery.Headers)+1)
+	for k, v := range delivery.Headers {
+		headers[k] = v
+	}
+	headers[HeaderAttempts] = int32(attempts) //nolint:gosec
+
+	err := c.publish(ctx, retryQueue(c.cfg.Queue, attempts-1), amqp.Publishing{
+		Headers:     headers,
+		ContentType: delivery.ContentType,
+		Body:        delivery.Body,
+	})
+	if err != nil {
+		return errors.Wrap(err, "[rabbitmq::Retry]")
+	}
+
+	return errors.Wrap(delivery.Ack(false), "[rabbitmq::Retry]: failed to ack amqp message")
+}
+
+// DeadLetter сообщение из очереди недоставленных.
+type DeadLetter struct {
+	Body     []byte
+	Attempts int
+	Reason   string
+	DeadAt   time.Time
+}
+
+// ListDeadLetters возвращает до limit сообщений из очереди недоставленных, не удаляя их.
+func (c *Client) ListDeadLetters(ctx context.Context, limit int) ([]*DeadLetter, error) {
+	channel, _, err := c.current()
+	if err != nil {
+		return nil, errors.Wrap(err, "[rabbitmq::ListDeadLetters]")
+	}
+
+	var (
+		result  []*DeadLetter
+		lastTag uint64
+	)
+
+	for len(result) < limit && ctx.Err() == nil {
+		delivery, ok, inErr := channel.Get(deadLetterQueue(c.cfg.Queue), false)
+		if inErr != nil {
+			return nil, errors.Wrap(inErr, "[rabbitmq::ListDeadLetters]: failed to get amqp message")
+		}
+
+		if !ok {
+			break
+		}
+
+		lastTag = delivery.DeliveryTag
+		result = append(result, newDeadLetter(&delivery))
+	}
+
+	if lastTag != 0 {
+		if err = channel.Nack(lastTag, true, true); err != nil {
+			return nil, errors.Wrap(err, "[rabbitmq::ListDeadLetters]: failed to return amqp messages")
+		}
+	}
+
+	return result, errors.Wrap(ctx.Err(), "[rabbitmq::ListDeadLetters]")
+}
+
+// ReplayDeadLetters возвращает до limit сообщений из очереди недоставленных в основную очередь
+// со сброшенным счетчиком попыток. Возвращает количество возвращенных сообщений.
+func (c *Client) ReplayDeadLetters(ctx context.Context, limit int) (int, error) {
+	channel, _, err := c.current()
+	if err != nil {
+		return 0, errors.Wrap(err, "[rabbitmq::ReplayDeadLetters]")
+	}
+
+	var replayed int
+
+	for replayed < limit {
+		if err = ctx.Err(); err != nil {
+			return replayed, errors.Wrap(err, "[rabbitmq::ReplayDeadLetters]")
+		}
+
+		delivery, ok, inErr := channel.Get(deadLetterQueue(c.cfg.Queue), false)
+		if inErr != nil {
+			return replayed, errors.Wrap(inErr, "[rabbitmq::ReplayDeadLetters]: failed to get amqp message")
+		}
+
+		if !ok {
+			break
+		}
+
+		headers := make(amqp.Table, len(delivery.Headers))
+		for k, v := range delivery.Headers {
+			if k != HeaderAttempts && k != "x-death" && k != "x-first-death-exchange" &&
+				k != "x-first-death-queue" && k != "x-first-death-reason" {
+				headers[k] = v
+			}
+		}
+
+		err = c.publish(ctx, c.cfg.Queue, amqp.Publishing{
+			Headers:     headers,
+			ContentType: delivery.ContentType,
+			Body:        delivery.Body,
+		})
+		if err != nil {
+			_ = delivery.Nack(false, true)
+			return replayed, errors.Wrap(err, "[rabbitmq::ReplayDeadLetters]")
+		}
+
+		if err = delivery.Ack(false); err != nil {
+			return replayed, errors.Wrap(err, "[rabbitmq::ReplayDeadLetters]: failed to ack amqp message")
+		}
+
+		replayed++
+	}
+
+	return replayed, nil
+}
+
+// newDeadLetter извлекает из сообщения счетчик попыток и причину из заголовка x-death.
+func newDeadLetter(delivery *amqp.Delivery) *DeadLetter {
+	deadLetter := &DeadLetter{
+		Body:     delivery.Body,
+		Attempts: Attempts(delivery.Headers),
+	}
+
+	if deaths, ok := delivery.Headers["x-death"].([]any); ok && len(deaths) > 0 {
+		if death, ok := deaths[0].(amqp.Table); ok {
+			deadLetter.Reason, _ = death["reason"].(string)
+			deadLetter.DeadAt, _ = death["time"].(time.Time)
+		}
+	}
+
+	return deadLetter
+}
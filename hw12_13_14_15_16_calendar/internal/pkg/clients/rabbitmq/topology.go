@@ -0,0 +1,62 @@
+package rabbitmq
+
+import (
+	"fmt"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/config"
+	"github.com/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// declareTopology объявляет очереди клиента:
+//   - основную очередь Queue, отклоненные сообщения которой уходят в dead-letter exchange;
+//   - очереди повторов Queue.retry.N с TTL из RetryDelays, по истечении которого сообщение
+//     возвращается в основную очередь;
+//   - dead-letter exchange Queue.dlx и привязанную к нему очередь недоставленных Queue.dlq.
+func declareTopology(channel *amqp.Channel, cfg config.MessageQueueConfig) error {
+	dlx, dlq := deadLetterExchange(cfg.Queue), deadLetterQueue(cfg.Queue)
+
+	if err := channel.ExchangeDeclare(dlx, amqp.ExchangeDirect, cfg.Durable, false, false, false, nil); err != nil {
+		return errors.Wrapf(err, "failed to declare amqp exchange %q", dlx)
+	}
+
+	if _, err := channel.QueueDeclare(dlq, cfg.Durable, false, false, false, nil); err != nil {
+		return errors.Wrapf(err, "failed to declare amqp queue %q", dlq)
+	}
+
+	if err := channel.QueueBind(dlq, cfg.Queue, dlx, false, nil); err != nil {
+		return errors.Wrapf(err, "failed to bind amqp queue %q to %q", dlq, dlx)
+	}
+
+	args := amqp.Table{"x-dead-letter-exchange": dlx}
+	if _, err := channel.QueueDeclare(cfg.Queue, cfg.Durable, false, !cfg.Durable, false, args); err != nil {
+		return errors.Wrapf(err, "failed to declare amqp queue %q", cfg.Queue)
+	}
+
+	for i, delay := range cfg.RetryDelays {
+		queue := retryQueue(cfg.Queue, i)
+		args := amqp.Table{
+			"x-message-ttl":             delay.Milliseconds(),
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": cfg.Queue,
+		}
+
+		if _, err := channel.QueueDeclare(queue, cfg.Durable, false, false, false, args); err != nil {
+			return errors.Wrapf(err, "failed to declare amqp queue %q", queue)
+		}
+	}
+
+	return nil
+}
+
+func retryQueue(queue string, tier int) string {
+	return fmt.Sprintf("%s.retry.%d", queue, tier+1)
+}
+
+func deadLetterExchange(queue string) string {
+	return queue + ".dlx"
+}
+
+func deadLetterQueue(queue string) string {
+	return queue + ".dlq"
+}
@@ -0,0 +1,427 @@
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrMalformed ошибка разбора iCalendar объекта.
+var ErrMalformed = errors.New("malformed iCalendar data")
+
+// maxLineBytes ограничение длины развернутой строки контента.
+const maxLineBytes = 1 << 20
+
+// EventError ошибка разбора отдельного VEVENT.
+type EventError struct {
+	Index int
+	UID   string
+	Err   error
+}
+
+// Error возвращает текст ошибки разбора VEVENT.
+func (e *EventError) Error() string {
+	return fmt.Sprintf("event #%d (UID %q): %v", e.Index, e.UID, e.Err)
+}
+
+// Unwrap возвращает исходную ошибку.
+func (e *EventError) Unwrap() error {
+	return e.Err
+}
+
+// property строка контента вида NAME;PARAM=VALUE:value.
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Decode разбирает iCalendar объект. Ошибки разбора отдельных VEVENT не прерывают разбор
+// и возвращаются списком EventError.
+func Decode(r io.Reader) (*Calendar, []*EventError, error) {
+	props, err := readProperties(r)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "[ical::Decode]")
+	}
+
+	if len(props) == 0 || props[0].name != "BEGIN" || !strings.EqualFold(props[0].value, "VCALENDAR") {
+		return nil, nil, errors.Wrap(ErrMalformed, "[ical::Decode]: VCALENDAR expected")
+	}
+
+	var (
+		cal       Calendar
+		eventErrs []*EventError
+		stack     []string
+		current   []property
+		index     int
+	)
+
+	for _, prop := range props {
+		switch prop.name {
+		case "BEGIN":
+			stack = append(stack, strings.ToUpper(prop.value))
+			if len(stack) == 2 && stack[1] == "VEVENT" {
+				current = current[:0]
+				continue
+			}
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1] != strings.ToUpper(prop.value) {
+				return nil, nil, errors.Wrapf(ErrMalformed, "[ical::Decode]: unexpected END:%s", prop.value)
+			}
+
+			stack = stack[:len(stack)-1]
+			if len(stack) == 1 && strings.EqualFold(prop.value, "VEVENT") {
+				event, inErr := decodeEvent(current)
+				if inErr != nil {
+					eventErrs = append(eventErrs, &EventError{Index: index, UID: event.UID, Err: inErr})
+				} else {
+					cal.Events = append(cal.Events, event)
+				}
+				index++
+
+				continue
+			}
+		}
+
+		switch {
+		case len(stack) == 1 && prop.name == "PRODID":
+			cal.ProdID = prop.value
+		case len(stack) == 1 && prop.name == "X-WR-CALNAME":
+			cal.Name = unescapeText(prop.value)
+		case len(stack) >= 2 && stack[1] == "VEVENT":
+			current = append(current, prop)
+		}
+	}
+
+	if len(stack) != 0 {
+		return nil, nil, errors.Wrapf(ErrMalformed, "[ical::Decode]: unterminated %s", stack[len(stack)-1])
+	}
+
+	return &cal, eventErrs, nil
+}
+
+// decodeEvent собирает VEVENT из его свойств, включая вложенные компоненты.
+func decodeEvent(props []property) (Event, error) {
+	var (
+		event      Event
+		start, end *property
+		duration   *property
+		trigger    *property
+		inAlarm    bool
+	)
+
+	for i := range props {
+		prop := &props[i]
+
+		if prop.name == "BEGIN" {
+			inAlarm = strings.EqualFold(prop.value, "VALARM")
+			continue
+		}
+
+		if prop.name == "END" {
+			inAlarm = false
+			continue
+		}
+
+		// из нескольких VALARM используется первый
+		if inAlarm {
+			if prop.name == "TRIGGER" && trigger == nil {
+				trigger = prop
+			}
+			continue
+		}
+
+		switch prop.name {
+		case "UID":
+			event.UID = prop.value
+		case "SUMMARY":
+			event.Summary = unescapeText(prop.value)
+		case "DESCRIPTION":
+			event.Description = unescapeText(prop.value)
+		case "DTSTAMP":
+			event.Stamp, _ = parseDateTime(prop)
+		case "DTSTART":
+			start = prop
+		case "DTEND":
+			end = prop
+		case "DURATION":
+			duration = prop
+		case "RRULE":
+			event.RRule = prop.value
+		case "EXDATE":
+			for _, value := range strings.Split(prop.value, ",") {
+				exDate, err := parseDateTime(&property{name: prop.name, params: prop.params, value: value})
+				if err != nil {
+					return event, err
+				}
+				event.ExDates = append(event.ExDates, exDate)
+			}
+		}
+	}
+
+	if start == nil {
+		return event, errors.New("DTSTART is required")
+	}
+
+	var err error
+	if event.Start, err = parseDateTime(start); err != nil {
+		return event, err
+	}
+
+	switch {
+	case end != nil:
+		if event.End, err = parseDateTime(end); err != nil {
+			return event, err
+		}
+	case duration != nil:
+		d, inErr := ParseDuration(duration.value)
+		if inErr != nil {
+			return event, inErr
+		}
+		event.End = event.Start.Add(d)
+	case isDate(start):
+		event.End = event.Start.AddDate(0, 0, 1)
+	default:
+		event.End = event.Start
+	}
+
+	if event.End.Before(event.Start) {
+		return event, errors.New("DTEND is before DTSTART")
+	}
+
+	if trigger != nil {
+		if event.Alarm, err = parseTrigger(trigger, event.Start); err != nil {
+			return event, err
+		}
+	}
+
+	return event, nil
+}
+
+// parseTrigger переводит TRIGGER в интервал напоминания до начала события.
+func parseTrigger(prop *property, start time.Time) (time.Duration, error) {
+	if strings.EqualFold(prop.params["VALUE"], "DATE-TIME") {
+		at, err := parseDateTime(prop)
+		if err != nil {
+			return 0, err
+		}
+		return max(start.Sub(at), 0), nil
+	}
+
+	d, err := ParseDuration(prop.value)
+	if err != nil {
+		return 0, err
+	}
+
+	return max(-d, 0), nil
+}
+
+func isDate(prop *property) bool {
+	return strings.EqualFold(prop.params["VALUE"], "DATE") || len(prop.value) == len(dateLayout)
+}
+
+// parseDateTime разбирает значение DATE или DATE-TIME с учетом параметра TZID.
+// Время без зоны ("floating") трактуется как UTC.
+func parseDateTime(prop *property) (time.Time, error) {
+	loc := time.UTC
+	if tzid := prop.params["TZID"]; tzid != "" {
+		var err error
+		if loc, err = time.LoadLocation(strings.TrimPrefix(tzid, "/")); err != nil {
+			return time.Time{}, errors.Errorf("%s: unknown TZID %q", prop.name, tzid)
+		}
+	}
+
+	value := strings.TrimSpace(prop.value)
+
+	layout := dateTimeLayout
+	switch {
+	case isDate(prop):
+		layout = dateLayout
+	case strings.HasSuffix(value, "Z"):
+		layout, loc = dateTimeUTCLayout, time.UTC
+	}
+
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return time.Time{}, errors.Errorf("%s: bad value %q", prop.name, prop.value)
+	}
+
+	return t, nil
+}
+
+// ParseDuration разбирает длительность в формате RFC 5545 (например, "-PT15M" или "P1DT2H").
+func ParseDuration(s string) (time.Duration, error) {
+	value := strings.TrimSpace(s)
+
+	sign := time.Duration(1)
+	switch {
+	case strings.HasPrefix(value, "-"):
+		sign, value = -1, value[1:]
+	case strings.HasPrefix(value, "+"):
+		value = value[1:]
+	}
+
+	if !strings.HasPrefix(value, "P") || len(value) < 3 {
+		return 0, errors.Errorf("bad duration %q", s)
+	}
+
+	var (
+		d      time.Duration
+		inTime bool
+		num    string
+	)
+
+	for _, c := range value[1:] {
+		switch {
+		case c >= '0' && c <= '9':
+			num += string(c)
+			continue
+		case c == 'T':
+			inTime = true
+			continue
+		}
+
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return 0, errors.Errorf("bad duration %q", s)
+		}
+		num = ""
+
+		var unit time.Duration
+		switch {
+		case c == 'W' && !inTime:
+			unit = 7 * 24 * time.Hour
+		case c == 'D' && !inTime:
+			unit = 24 * time.Hour
+		case c == 'H' && inTime:
+			unit = time.Hour
+		case c == 'M' && inTime:
+			unit = time.Minute
+		case c == 'S' && inTime:
+			unit = time.Second
+		default:
+			return 0, errors.Errorf("bad duration %q", s)
+		}
+
+		d += time.Duration(n) * unit
+	}
+
+	if num != "" {
+		return 0, errors.Errorf("bad duration %q", s)
+	}
+
+	return sign * d, nil
+}
+
+// readProperties читает и разворачивает строки контента.
+func readProperties(r io.Reader) ([]property, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "can't read data")
+	}
+
+	props := make([]property, 0, len(lines))
+	for _, line := range lines {
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, err
+		}
+		props = append(props, prop)
+	}
+
+	return props, nil
+}
+
+// parseProperty разбирает строку контента, учитывая значения параметров в кавычках.
+func parseProperty(line string) (property, error) {
+	prop := property{params: make(map[string]string)}
+
+	var (
+		i       int
+		inQuote bool
+	)
+
+	for i = 0; i < len(line); i++ {
+		if line[i] == '"' {
+			inQuote = !inQuote
+		}
+
+		if !inQuote && line[i] == ':' {
+			break
+		}
+	}
+
+	if i == len(line) {
+		return prop, errors.Wrapf(ErrMalformed, "bad content line %q", line)
+	}
+
+	head, value := line[:i], line[i+1:]
+	prop.value = value
+
+	parts := splitUnquoted(head, ';')
+	prop.name = strings.ToUpper(parts[0])
+
+	for _, param := range parts[1:] {
+		key, val, found := strings.Cut(param, "=")
+		if !found {
+			return prop, errors.Wrapf(ErrMalformed, "bad parameter %q", param)
+		}
+		prop.params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+
+	return prop, nil
+}
+
+func splitUnquoted(s string, sep byte) []string {
+	var (
+		parts   []string
+		inQuote bool
+		start   int
+	)
+
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '"':
+			inQuote = !inQuote
+		case s[i] == sep && !inQuote:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+var textUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, ";",
+	`\,`, ",",
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
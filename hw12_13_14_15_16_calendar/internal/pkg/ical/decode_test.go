@@ -0,0 +1,123 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const sample = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Other//Calendar//EN\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Europe/Moscow\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:first@example.com\r\n" +
+	"DTSTART;TZID=Europe/Moscow:20250106T100000\r\n" +
+	"DTEND;TZID=Europe/Moscow:20250106T110000\r\n" +
+	"SUMMARY:Meeting with the auditors\\, room 1\r\n" +
+	"DESCRIPTION:Bring the\\nreports\r\n" +
+	"RRULE:FREQ=WEEKLY;COUNT=3\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT30M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:broken@example.com\r\n" +
+	"SUMMARY:No start\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:all-day@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20250107\r\n" +
+	"SUMMARY:Long summary that is folded\r\n" +
+	"  across two lines\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestDecode(t *testing.T) {
+	cal, eventErrs, err := Decode(strings.NewReader(sample))
+	require.NoError(t, err)
+	require.Equal(t, "-//Other//Calendar//EN", cal.ProdID)
+
+	require.Len(t, eventErrs, 1)
+	require.Equal(t, 1, eventErrs[0].Index)
+	require.Equal(t, "broken@example.com", eventErrs[0].UID)
+
+	require.Len(t, cal.Events, 2)
+
+	first := cal.Events[0]
+	require.Equal(t, "first@example.com", first.UID)
+	require.Equal(t, "Meeting with the auditors, room 1", first.Summary)
+	require.Equal(t, "Bring the\nreports", first.Description)
+	require.True(t, time.Date(2025, time.January, 6, 7, 0, 0, 0, time.UTC).Equal(first.Start))
+	require.Equal(t, time.Hour, first.End.Sub(first.Start))
+	require.Equal(t, "FREQ=WEEKLY;COUNT=3", first.RRule)
+	require.Equal(t, 30*time.Minute, first.Alarm)
+
+	allDay := cal.Events[1]
+	require.Equal(t, "Long summary that is folded across two lines", allDay.Summary)
+	require.Equal(t, time.Date(2025, time.January, 7, 0, 0, 0, 0, time.UTC), allDay.Start)
+	require.Equal(t, 24*time.Hour, allDay.End.Sub(allDay.Start))
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	for _, data := range []string{
+		"",
+		"BEGIN:VEVENT\r\nEND:VEVENT\r\n",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n",
+		"BEGIN:VCALENDAR\r\nno colon here\r\nEND:VCALENDAR\r\n",
+	} {
+		_, _, err := Decode(strings.NewReader(data))
+		require.ErrorIs(t, err, ErrMalformed, data)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	start := time.Date(2025, time.January, 6, 10, 0, 0, 0, time.UTC)
+	cal := Calendar{
+		ProdID: "-//test//RU",
+		Events: []Event{{
+			UID:         "1@calendar",
+			Stamp:       start,
+			Start:       start,
+			End:         start.Add(time.Hour),
+			Summary:     "Title; with, specials\\",
+			Description: strings.Repeat("long ", 40),
+			RRule:       "FREQ=DAILY;COUNT=2",
+			ExDates:     []time.Time{start.AddDate(0, 0, 1)},
+			Alarm:       time.Hour,
+		}},
+	}
+
+	var buf strings.Builder
+	require.NoError(t, Encode(&buf, &cal))
+
+	decoded, eventErrs, err := Decode(strings.NewReader(buf.String()))
+	require.NoError(t, err)
+	require.Empty(t, eventErrs)
+	require.Equal(t, cal.Events, decoded.Events)
+}
+
+func TestParseDuration(t *testing.T) {
+	for s, expected := range map[string]time.Duration{
+		"PT15M":     15 * time.Minute,
+		"-PT15M":    -15 * time.Minute,
+		"+P1DT2H":   26 * time.Hour,
+		"P2W":       14 * 24 * time.Hour,
+		"-P1DT1S":   -(24*time.Hour + time.Second),
+		"PT1H30M5S": time.Hour + 30*time.Minute + 5*time.Second,
+	} {
+		d, err := ParseDuration(s)
+		require.NoError(t, err, s)
+		require.Equal(t, expected, d, s)
+	}
+
+	for _, s := range []string{"", "P", "PT", "15M", "P1H", "PT1D", "PT15"} {
+		_, err := ParseDuration(s)
+		require.Error(t, err, s)
+	}
+}
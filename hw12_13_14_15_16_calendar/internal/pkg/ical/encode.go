@@ -0,0 +1,166 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// maxLineOctets максимальная длина строки контента без CRLF (RFC 5545, 3.1).
+const maxLineOctets = 75
+
+// Encode записывает календарь в формате RFC 5545.
+func Encode(w io.Writer, cal *Calendar) error {
+	e := encoder{w: bufio.NewWriter(w)}
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", cal.ProdID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(cal.Name))
+	}
+
+	for i := range cal.Events {
+		e.event(&cal.Events[i])
+	}
+
+	e.line("END", "VCALENDAR")
+
+	if e.err != nil {
+		return errors.Wrap(e.err, "[ical::Encode]: can't write calendar")
+	}
+
+	return errors.Wrap(e.w.Flush(), "[ical::Encode]: can't flush calendar")
+}
+
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (e *encoder) event(event *Event) {
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", event.UID)
+	e.line("DTSTAMP", formatUTC(event.Stamp))
+	e.line("DTSTART", formatUTC(event.Start))
+	e.line("DTEND", formatUTC(event.End))
+	e.line("SUMMARY", escapeText(event.Summary))
+
+	if event.Description != "" {
+		e.line("DESCRIPTION", escapeText(event.Description))
+	}
+
+	if event.RRule != "" {
+		e.line("RRULE", event.RRule)
+	}
+
+	if len(event.ExDates) > 0 {
+		exDates := make([]string, 0, len(event.ExDates))
+		for _, exDate := range event.ExDates {
+			exDates = append(exDates, formatUTC(exDate))
+		}
+		e.line("EXDATE", strings.Join(exDates, ","))
+	}
+
+	if event.Alarm > 0 {
+		e.line("BEGIN", "VALARM")
+		e.line("ACTION", "DISPLAY")
+		e.line("DESCRIPTION", escapeText(event.Summary))
+		e.line("TRIGGER", FormatDuration(-event.Alarm))
+		e.line("END", "VALARM")
+	}
+
+	e.line("END", "VEVENT")
+}
+
+// line записывает строку контента "NAME:value", сворачивая ее по 75 октетов.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+
+	s := name + ":" + value
+
+	// строки продолжения начинаются с пробела, который тоже входит в лимит
+	for limit := maxLineOctets; len(s) > limit; limit = maxLineOctets - 1 {
+		cut := limit
+		for !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+
+		if _, e.err = e.w.WriteString(s[:cut] + "\r\n "); e.err != nil {
+			return
+		}
+
+		s = s[cut:]
+	}
+
+	_, e.err = e.w.WriteString(s + "\r\n")
+}
+
+func formatUTC(t time.Time) string {
+	return t.UTC().Format(dateTimeUTCLayout)
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// FormatDuration форматирует длительность в формате RFC 5545 (например, "-PT15M" или "P1DT2H").
+func FormatDuration(d time.Duration) string {
+	var b strings.Builder
+
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+
+	b.WriteByte('P')
+
+	if d == 0 {
+		b.WriteString("T0S")
+		return b.String()
+	}
+
+	day := 24 * time.Hour
+	if d%(7*day) == 0 {
+		b.WriteString(strconv.FormatInt(int64(d/(7*day)), 10) + "W")
+		return b.String()
+	}
+
+	if days := d / day; days > 0 {
+		b.WriteString(strconv.FormatInt(int64(days), 10) + "D")
+		d -= days * day
+	}
+
+	if d > 0 {
+		b.WriteByte('T')
+	}
+
+	for _, unit := range []struct {
+		size   time.Duration
+		suffix string
+	}{{time.Hour, "H"}, {time.Minute, "M"}, {time.Second, "S"}} {
+		if n := d / unit.size; n > 0 {
+			b.WriteString(strconv.FormatInt(int64(n), 10) + unit.suffix)
+			d -= n * unit.size
+		}
+	}
+
+	return b.String()
+}
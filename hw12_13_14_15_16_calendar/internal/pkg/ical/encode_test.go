@@ -0,0 +1,58 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	start := time.Date(2025, time.January, 6, 10, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	cal := Calendar{
+		ProdID: "-//test//RU",
+		Name:   "Work",
+		Events: []Event{{
+			UID:         "1@calendar",
+			Stamp:       start,
+			Start:       start,
+			End:         start.Add(time.Hour),
+			Summary:     "Stand-up; daily, short",
+			Description: strings.Repeat("очень длинное описание ", 5) + "\nвторая строка",
+			Alarm:       15 * time.Minute,
+		}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Encode(&buf, &cal))
+
+	out := buf.String()
+	require.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	require.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	require.Contains(t, out, "DTSTART:20250106T070000Z\r\n")
+	require.Contains(t, out, `SUMMARY:Stand-up\; daily\, short`+"\r\n")
+	require.Contains(t, out, "BEGIN:VALARM\r\nACTION:DISPLAY\r\n")
+	require.Contains(t, out, "TRIGGER:-PT15M\r\n")
+
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(line), maxLineOctets, line)
+	}
+
+	unfolded := strings.ReplaceAll(out, "\r\n ", "")
+	require.Contains(t, unfolded, "DESCRIPTION:"+strings.Repeat("очень длинное описание ", 5)+"\\nвторая строка\r\n")
+}
+
+func TestFormatDuration(t *testing.T) {
+	for d, expected := range map[time.Duration]string{
+		0:                             "PT0S",
+		15 * time.Minute:              "PT15M",
+		-15 * time.Minute:             "-PT15M",
+		26*time.Hour + 30*time.Second: "P1DT2H30S",
+		14 * 24 * time.Hour:           "P2W",
+	} {
+		require.Equal(t, expected, FormatDuration(d))
+	}
+}
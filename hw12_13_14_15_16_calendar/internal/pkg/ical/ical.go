@@ -0,0 +1,30 @@
+package ical
+
+import "time"
+
+// Calendar модель iCalendar объекта (VCALENDAR).
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []Event
+}
+
+// Event модель календарного события (VEVENT).
+type Event struct {
+	UID         string
+	Stamp       time.Time
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	RRule       string
+	ExDates     []time.Time
+	// Alarm время напоминания до начала события (VALARM), 0 - без напоминания.
+	Alarm time.Duration
+}
+
+const (
+	dateTimeUTCLayout = "20060102T150405Z"
+	dateTimeLayout    = "20060102T150405"
+	dateLayout        = "20060102"
+)
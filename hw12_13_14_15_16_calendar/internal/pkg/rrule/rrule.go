@@ -0,0 +1,385 @@
+package rrule
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidRule ошибка разбора правила повторения.
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// Frequency строковый алиас для частоты повторения (FREQ).
+type Frequency = string
+
+// Поддерживаемые частоты повторения.
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyYearly  Frequency = "YEARLY"
+)
+
+const untilLayout = "20060102T150405Z"
+
+const dateLayout = "20060102"
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// WeekdayNum день недели из BYDAY с необязательным порядковым номером (например, 2TU или -1FR).
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Rule правило повторения (подмножество RRULE из RFC 5545).
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []WeekdayNum
+	Count    int
+	Until    *time.Time
+}
+
+// Parse разбирает строку вида "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10".
+func Parse(s string) (*Rule, error) {
+	rule := Rule{Interval: 1}
+
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, errors.Wrapf(ErrInvalidRule, "[rrule::Parse]: malformed part %q", part)
+		}
+
+		if err := rule.set(strings.ToUpper(key), strings.ToUpper(value)); err != nil {
+			return nil, errors.Wrap(err, "[rrule::Parse]")
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return nil, errors.Wrap(err, "[rrule::Parse]")
+	}
+
+	return &rule, nil
+}
+
+func (r *Rule) set(key, value string) error {
+	switch key {
+	case "FREQ":
+		r.Freq = value
+	case "INTERVAL":
+		interval, err := strconv.Atoi(value)
+		if err != nil || interval < 1 {
+			return errors.Wrapf(ErrInvalidRule, "bad INTERVAL %q", value)
+		}
+		r.Interval = interval
+	case "COUNT":
+		count, err := strconv.Atoi(value)
+		if err != nil || count < 1 {
+			return errors.Wrapf(ErrInvalidRule, "bad COUNT %q", value)
+		}
+		r.Count = count
+	case "UNTIL":
+		until, err := parseUntil(value)
+		if err != nil {
+			return err
+		}
+		r.Until = &until
+	case "BYDAY":
+		for _, day := range strings.Split(value, ",") {
+			wdn, err := parseWeekdayNum(day)
+			if err != nil {
+				return err
+			}
+			r.ByDay = append(r.ByDay, wdn)
+		}
+	case "WKST":
+		if value != "MO" {
+			return errors.Wrapf(ErrInvalidRule, "unsupported WKST %q", value)
+		}
+	default:
+		return errors.Wrapf(ErrInvalidRule, "unsupported rule part %q", key)
+	}
+
+	return nil
+}
+
+func (r *Rule) validate() error {
+	switch r.Freq {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+	case "":
+		return errors.Wrap(ErrInvalidRule, "FREQ is required")
+	default:
+		return errors.Wrapf(ErrInvalidRule, "unsupported FREQ %q", r.Freq)
+	}
+
+	if r.Count > 0 && r.Until != nil {
+		return errors.Wrap(ErrInvalidRule, "COUNT and UNTIL are mutually exclusive")
+	}
+
+	for _, day := range r.ByDay {
+		if day.N != 0 && r.Freq != FrequencyMonthly {
+			return errors.Wrapf(ErrInvalidRule, "ordinal BYDAY is supported only with FREQ=%s", FrequencyMonthly)
+		}
+	}
+
+	if len(r.ByDay) > 0 && r.Freq == FrequencyYearly {
+		return errors.Wrapf(ErrInvalidRule, "BYDAY is not supported with FREQ=%s", FrequencyYearly)
+	}
+
+	return nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if until, err := time.Parse(untilLayout, value); err == nil {
+		return until, nil
+	}
+
+	until, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(ErrInvalidRule, "bad UNTIL %q", value)
+	}
+
+	// UNTIL в виде даты включает весь указанный день.
+	return until.Add(24*time.Hour - time.Nanosecond), nil
+}
+
+func parseWeekdayNum(value string) (WeekdayNum, error) {
+	if len(value) < 2 {
+		return WeekdayNum{}, errors.Wrapf(ErrInvalidRule, "bad BYDAY %q", value)
+	}
+
+	weekday, ok := weekdays[value[len(value)-2:]]
+	if !ok {
+		return WeekdayNum{}, errors.Wrapf(ErrInvalidRule, "bad BYDAY %q", value)
+	}
+
+	var n int
+	if prefix := value[:len(value)-2]; prefix != "" {
+		var err error
+		if n, err = strconv.Atoi(prefix); err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, errors.Wrapf(ErrInvalidRule, "bad BYDAY %q", value)
+		}
+	}
+
+	return WeekdayNum{Weekday: weekday, N: n}, nil
+}
+
+// String возвращает правило в формате RFC 5545.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			days = append(days, day.String())
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// String возвращает день недели в формате BYDAY.
+func (w WeekdayNum) String() string {
+	for code, weekday := range weekdays {
+		if weekday == w.Weekday {
+			if w.N != 0 {
+				return strconv.Itoa(w.N) + code
+			}
+			return code
+		}
+	}
+
+	return ""
+}
+
+// Iterate последовательно перебирает начала вхождений серии, начинающейся в dtstart,
+// пока fn возвращает true или пока серия не закончится по COUNT/UNTIL.
+func (r *Rule) Iterate(dtstart time.Time, fn func(t time.Time) bool) {
+	var generated, empty int
+
+	for n := 0; empty < maxEmptyPeriods; n++ {
+		if r.Until != nil && r.periodStart(dtstart, n).After(*r.Until) {
+			return
+		}
+
+		candidates := r.candidates(dtstart, n)
+		if len(candidates) == 0 {
+			empty++
+			continue
+		}
+		empty = 0
+
+		for _, t := range candidates {
+			if t.Before(dtstart) {
+				continue
+			}
+
+			if r.Until != nil && t.After(*r.Until) {
+				return
+			}
+
+			generated++
+			if !fn(t) {
+				return
+			}
+
+			if r.Count > 0 && generated >= r.Count {
+				return
+			}
+		}
+	}
+}
+
+// Last возвращает начало последнего вхождения серии; false, если серия бесконечна.
+func (r *Rule) Last(dtstart time.Time) (time.Time, bool) {
+	if r.Count == 0 && r.Until == nil {
+		return time.Time{}, false
+	}
+
+	last := dtstart
+	r.Iterate(dtstart, func(t time.Time) bool {
+		last = t
+		return true
+	})
+
+	return last, true
+}
+
+// maxEmptyPeriods ограничивает число подряд идущих периодов без вхождений
+// (например, FREQ=DAILY;INTERVAL=7;BYDAY=TU с началом в понедельник никогда не совпадет).
+const maxEmptyPeriods = 1000
+
+func (r *Rule) periodStart(dtstart time.Time, n int) time.Time {
+	step := n * r.Interval
+
+	switch r.Freq {
+	case FrequencyDaily:
+		return dtstart.AddDate(0, 0, step)
+	case FrequencyWeekly:
+		return startOfWeek(dtstart).AddDate(0, 0, 7*step)
+	case FrequencyMonthly:
+		return time.Date(dtstart.Year(), dtstart.Month()+time.Month(step), 1,
+			dtstart.Hour(), dtstart.Minute(), dtstart.Second(), dtstart.Nanosecond(), dtstart.Location())
+	default:
+		return time.Date(dtstart.Year()+step, dtstart.Month(), 1,
+			dtstart.Hour(), dtstart.Minute(), dtstart.Second(), dtstart.Nanosecond(), dtstart.Location())
+	}
+}
+
+func (r *Rule) candidates(dtstart time.Time, n int) []time.Time {
+	start := r.periodStart(dtstart, n)
+
+	switch r.Freq {
+	case FrequencyDaily:
+		if len(r.ByDay) > 0 && !r.hasWeekday(start.Weekday()) {
+			return []time.Time{}
+		}
+		return []time.Time{start}
+	case FrequencyWeekly:
+		if len(r.ByDay) == 0 {
+			return []time.Time{start.AddDate(0, 0, weekdayOffset(dtstart.Weekday()))}
+		}
+
+		result := make([]time.Time, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			result = append(result, start.AddDate(0, 0, weekdayOffset(day.Weekday)))
+		}
+		slices.SortFunc(result, time.Time.Compare)
+
+		return slices.CompactFunc(result, time.Time.Equal)
+	case FrequencyMonthly:
+		if len(r.ByDay) == 0 {
+			return sameDay(start, dtstart.Day())
+		}
+		return r.monthlyByDay(start)
+	default:
+		return sameDay(start, dtstart.Day())
+	}
+}
+
+func (r *Rule) monthlyByDay(monthStart time.Time) []time.Time {
+	var days []time.Time
+	for t := monthStart; t.Month() == monthStart.Month(); t = t.AddDate(0, 0, 1) {
+		days = append(days, t)
+	}
+
+	var result []time.Time
+	for _, byDay := range r.ByDay {
+		var matched []time.Time
+		for _, day := range days {
+			if day.Weekday() == byDay.Weekday {
+				matched = append(matched, day)
+			}
+		}
+
+		switch {
+		case byDay.N == 0:
+			result = append(result, matched...)
+		case byDay.N > 0 && byDay.N <= len(matched):
+			result = append(result, matched[byDay.N-1])
+		case byDay.N < 0 && -byDay.N <= len(matched):
+			result = append(result, matched[len(matched)+byDay.N])
+		}
+	}
+
+	slices.SortFunc(result, time.Time.Compare)
+
+	return slices.CompactFunc(result, time.Time.Equal)
+}
+
+func (r *Rule) hasWeekday(weekday time.Weekday) bool {
+	return slices.ContainsFunc(r.ByDay, func(day WeekdayNum) bool {
+		return day.Weekday == weekday
+	})
+}
+
+// sameDay возвращает указанный день месяца, если он существует (31 февраля пропускается).
+func sameDay(monthStart time.Time, day int) []time.Time {
+	t := monthStart.AddDate(0, 0, day-1)
+	if t.Month() != monthStart.Month() {
+		return []time.Time{}
+	}
+
+	return []time.Time{t}
+}
+
+// startOfWeek возвращает понедельник недели, в которую входит t (WKST=MO).
+func startOfWeek(t time.Time) time.Time {
+	return t.AddDate(0, 0, -weekdayOffset(t.Weekday()))
+}
+
+// weekdayOffset возвращает смещение дня недели от понедельника.
+func weekdayOffset(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
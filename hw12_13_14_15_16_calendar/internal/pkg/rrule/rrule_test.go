@@ -0,0 +1,133 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func collect(t *testing.T, s string, dtstart time.Time, limit int) []time.Time {
+	t.Helper()
+
+	rule, err := Parse(s)
+	require.NoError(t, err)
+
+	var result []time.Time
+	rule.Iterate(dtstart, func(t time.Time) bool {
+		result = append(result, t)
+		return len(result) < limit
+	})
+
+	return result
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 10, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	rule, err := Parse("RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10")
+	require.NoError(t, err)
+	require.Equal(t, FrequencyWeekly, rule.Freq)
+	require.Equal(t, 2, rule.Interval)
+	require.Equal(t, []WeekdayNum{{Weekday: time.Monday}, {Weekday: time.Wednesday}}, rule.ByDay)
+	require.Equal(t, 10, rule.Count)
+	require.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10", rule.String())
+
+	rule, err = Parse("FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20250101")
+	require.NoError(t, err)
+	require.Equal(t, []WeekdayNum{{Weekday: time.Friday, N: -1}}, rule.ByDay)
+	require.Equal(t, time.Date(2025, time.January, 1, 23, 59, 59, 999999999, time.UTC), *rule.Until)
+
+	for _, s := range []string{
+		"",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101T000000Z",
+		"FREQ=WEEKLY;BYDAY=2MO",
+		"FREQ=YEARLY;BYDAY=MO",
+		"FREQ=DAILY;BYMONTH=1",
+		"FREQ=DAILY;BYDAY=XX",
+	} {
+		_, err = Parse(s)
+		require.ErrorIs(t, err, ErrInvalidRule, s)
+	}
+}
+
+func TestIterate(t *testing.T) {
+	t.Run("daily with interval and count", func(t *testing.T) {
+		got := collect(t, "FREQ=DAILY;INTERVAL=2;COUNT=3", date(2025, time.January, 30), 100)
+		require.Equal(t, []time.Time{
+			date(2025, time.January, 30),
+			date(2025, time.February, 1),
+			date(2025, time.February, 3),
+		}, got)
+	})
+
+	t.Run("weekly by day", func(t *testing.T) {
+		// 2025-01-01 - среда.
+		got := collect(t, "FREQ=WEEKLY;BYDAY=MO,WE", date(2025, time.January, 1), 4)
+		require.Equal(t, []time.Time{
+			date(2025, time.January, 1),
+			date(2025, time.January, 6),
+			date(2025, time.January, 8),
+			date(2025, time.January, 13),
+		}, got)
+	})
+
+	t.Run("weekly until", func(t *testing.T) {
+		got := collect(t, "FREQ=WEEKLY;UNTIL=20250115T100000Z", date(2025, time.January, 1), 100)
+		require.Equal(t, []time.Time{
+			date(2025, time.January, 1),
+			date(2025, time.January, 8),
+			date(2025, time.January, 15),
+		}, got)
+	})
+
+	t.Run("monthly skips missing days", func(t *testing.T) {
+		got := collect(t, "FREQ=MONTHLY;COUNT=3", date(2025, time.January, 31), 100)
+		require.Equal(t, []time.Time{
+			date(2025, time.January, 31),
+			date(2025, time.March, 31),
+			date(2025, time.May, 31),
+		}, got)
+	})
+
+	t.Run("monthly last friday", func(t *testing.T) {
+		got := collect(t, "FREQ=MONTHLY;BYDAY=-1FR", date(2025, time.January, 1), 2)
+		require.Equal(t, []time.Time{
+			date(2025, time.January, 31),
+			date(2025, time.February, 28),
+		}, got)
+	})
+
+	t.Run("yearly leap day", func(t *testing.T) {
+		got := collect(t, "FREQ=YEARLY;COUNT=2", date(2024, time.February, 29), 100)
+		require.Equal(t, []time.Time{
+			date(2024, time.February, 29),
+			date(2028, time.February, 29),
+		}, got)
+	})
+
+	t.Run("never matching rule terminates", func(t *testing.T) {
+		// 2025-01-06 - понедельник, шаг в 7 дней никогда не попадет на вторник.
+		got := collect(t, "FREQ=DAILY;INTERVAL=7;BYDAY=TU", date(2025, time.January, 6), 100)
+		require.Empty(t, got)
+	})
+}
+
+func TestLast(t *testing.T) {
+	rule, err := Parse("FREQ=DAILY;COUNT=3")
+	require.NoError(t, err)
+
+	last, ok := rule.Last(date(2025, time.January, 1))
+	require.True(t, ok)
+	require.Equal(t, date(2025, time.January, 3), last)
+
+	rule, err = Parse("FREQ=DAILY")
+	require.NoError(t, err)
+
+	_, ok = rule.Last(date(2025, time.January, 1))
+	require.False(t, ok)
+}
@@ -0,0 +1,157 @@
+package internalgrpc
+
+import (
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toPBEvent конвертирует событие из модели БД в grpc модель.
+func toPBEvent(event *storage.Event) *eventspb.Event {
+	exDates := make([]*timestamppb.Timestamp, 0, len(event.ExDates))
+	for _, exDate := range event.ExDates {
+		exDates = append(exDates, timestamppb.New(exDate))
+	}
+
+	return &eventspb.Event{
+		Id:             event.ID,
+		Title:          event.Title,
+		StartsAt:       timestamppb.New(*event.StartsAt),
+		EndsAt:         timestamppb.New(*event.EndsAt),
+		Description:    event.Description,
+		UserId:         event.UserID,
+		NotifyInterval: durationpb.New(event.NotifyInterval),
+		Rrule:          event.RRule,
+		Exdates:        exDates,
+		Tentative:      event.Tentative,
+		Attendees:      toPBAttendees(event.Attendees),
+		CalendarId:     event.CalendarID,
+	}
+}
+
+// pbAttendeeRoles соответствие ролей участников grpc модели.
+var pbAttendeeRoles = map[storage.AttendeeRole]eventspb.AttendeeRole{
+	storage.AttendeeRoleRequired: eventspb.AttendeeRole_ATTENDEE_ROLE_REQUIRED,
+	storage.AttendeeRoleOptional: eventspb.AttendeeRole_ATTENDEE_ROLE_OPTIONAL,
+}
+
+// pbRSVPStatuses соответствие ответов на приглашение grpc модели.
+var pbRSVPStatuses = map[storage.RSVPStatus]eventspb.RSVPStatus{
+	storage.RSVPStatusNeedsAction: eventspb.RSVPStatus_RSVP_STATUS_NEEDS_ACTION,
+	storage.RSVPStatusAccepted:    eventspb.RSVPStatus_RSVP_STATUS_ACCEPTED,
+	storage.RSVPStatusDeclined:    eventspb.RSVPStatus_RSVP_STATUS_DECLINED,
+	storage.RSVPStatusTentative:   eventspb.RSVPStatus_RSVP_STATUS_TENTATIVE,
+}
+
+// toPBAttendees конвертирует список участников события из модели БД в grpc модель.
+func toPBAttendees(attendees []*storage.Attendee) []*eventspb.Attendee {
+	result := make([]*eventspb.Attendee, 0, len(attendees))
+	for _, attendee := range attendees {
+		result = append(result, &eventspb.Attendee{
+			UserId: attendee.UserID,
+			Role:   pbAttendeeRoles[attendee.Role],
+			Status: pbRSVPStatuses[attendee.Status],
+		})
+	}
+
+	return result
+}
+
+// toPBEvents конвертирует список событий из модели БД в grpc модель.
+func toPBEvents(events []*storage.Event) []*eventspb.Event {
+	result := make([]*eventspb.Event, 0, len(events))
+	for _, event := range events {
+		result = append(result, toPBEvent(event))
+	}
+
+	return result
+}
+
+// pbChangeTypes соответствие типов изменения события grpc модели.
+var pbChangeTypes = map[app.ChangeType]eventspb.EventChange_Type{
+	app.ChangeTypeCreated: eventspb.EventChange_TYPE_CREATED,
+	app.ChangeTypeUpdated: eventspb.EventChange_TYPE_UPDATED,
+	app.ChangeTypeDeleted: eventspb.EventChange_TYPE_DELETED,
+}
+
+// toPBEventChange конвертирует изменение события в grpc модель.
+func toPBEventChange(change *app.EventChange) *eventspb.EventChange {
+	return &eventspb.EventChange{
+		Type:      pbChangeTypes[change.Type],
+		EventId:   change.EventID,
+		Event:     toPBEvent(change.Event),
+		ChangedAt: timestamppb.New(change.ChangedAt),
+	}
+}
+
+// pbPermissions соответствие уровней доступа grpc модели.
+var pbPermissions = map[storage.Permission]eventspb.Permission{
+	storage.PermissionFreeBusy: eventspb.Permission_PERMISSION_FREE_BUSY,
+	storage.PermissionRead:     eventspb.Permission_PERMISSION_READ,
+	storage.PermissionWrite:    eventspb.Permission_PERMISSION_WRITE,
+}
+
+// toPBGrants конвертирует список разрешений из модели БД в grpc модель.
+func toPBGrants(grants []*storage.Grant) []*eventspb.Grant {
+	result := make([]*eventspb.Grant, 0, len(grants))
+	for _, grant := range grants {
+		result = append(result, &eventspb.Grant{
+			OwnerId:    grant.OwnerID,
+			GranteeId:  grant.GranteeID,
+			Permission: pbPermissions[grant.Permission],
+			CreatedAt:  timestamppb.New(grant.CreatedAt),
+		})
+	}
+
+	return result
+}
+
+// toPBCalendar конвертирует календарь из модели БД в grpc модель.
+func toPBCalendar(calendar *storage.Calendar) *eventspb.Calendar {
+	return &eventspb.Calendar{
+		Id:                    calendar.ID,
+		UserId:                calendar.UserID,
+		Name:                  calendar.Name,
+		Color:                 calendar.Color,
+		DefaultNotifyInterval: durationpb.New(calendar.DefaultNotifyInterval),
+		IsDefault:             calendar.IsDefault,
+		CreatedAt:             timestamppb.New(calendar.CreatedAt),
+	}
+}
+
+// toPBCalendars конвертирует список календарей из модели БД в grpc модель.
+func toPBCalendars(calendars []*storage.Calendar) []*eventspb.Calendar {
+	result := make([]*eventspb.Calendar, 0, len(calendars))
+	for _, calendar := range calendars {
+		result = append(result, toPBCalendar(calendar))
+	}
+
+	return result
+}
+
+// fromPBTimestamps конвертирует список grpc меток времени в []time.Time.
+func fromPBTimestamps(timestamps []*timestamppb.Timestamp) []time.Time {
+	if len(timestamps) == 0 {
+		return nil
+	}
+
+	result := make([]time.Time, 0, len(timestamps))
+	for _, ts := range timestamps {
+		result = append(result, ts.AsTime())
+	}
+
+	return result
+}
+
+// fromPBOptionalTimestamp конвертирует необязательную grpc метку времени, отсутствие метки - нулевое время.
+func fromPBOptionalTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
@@ -0,0 +1,37 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateCalendar имплементация grpc метода CreateCalendar.
+func (i *Implementation) CreateCalendar(
+	ctx context.Context,
+	req *eventspb.CreateCalendarRequest,
+) (*eventspb.Calendar, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := i.app.CreateCalendar(ctx, userID, req.Name, req.Color, req.DefaultNotifyInterval.AsDuration())
+	if err != nil {
+		if errors.Is(err, app.ErrInvalidCalendar) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid calendar: %v", err)
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to create calendar")
+	}
+
+	return toPBCalendar(calendar), nil
+}
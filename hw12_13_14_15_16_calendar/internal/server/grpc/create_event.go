@@ -3,25 +3,49 @@ package internalgrpc
 import (
 	"context"
 
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
 	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/rrule"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // CreateEvent имплементация grpc метода CreateEvent.
 func (i *Implementation) CreateEvent(ctx context.Context, req *eventspb.Event) (*eventspb.CreateEventResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
 	startsAt := req.StartsAt.AsTime()
 	endsAt := req.EndsAt.AsTime()
 
-	if err := i.app.CreateEvent(
+	if err = i.app.CreateEvent(
 		ctx,
 		req.Title,
 		req.Description,
-		req.UserId,
+		userID,
+		req.CalendarId,
 		&startsAt,
 		&endsAt,
 		req.NotifyInterval.AsDuration(),
+		req.Rrule,
+		fromPBTimestamps(req.Exdates),
+		req.Tentative,
 	); err != nil {
+		if errors.Is(err, rrule.ErrInvalidRule) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid recurrence rule: %v", err)
+		}
+
+		if errors.Is(err, app.ErrDateBusy) {
+			return nil, status.Errorf(codes.AlreadyExists, "Date is busy: %v", err)
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
 		return nil, status.Errorf(codes.Internal, "Failed to create event: %v", err)
 	}
 
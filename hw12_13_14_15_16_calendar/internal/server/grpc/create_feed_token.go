@@ -0,0 +1,38 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateFeedToken имплементация grpc метода CreateFeedToken.
+func (i *Implementation) CreateFeedToken(
+	ctx context.Context,
+	req *eventspb.CreateFeedTokenRequest,
+) (*eventspb.CreateFeedTokenResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := i.app.CreateFeedToken(ctx, userID)
+	if err != nil {
+		if errors.Is(err, app.ErrFeedsDisabled) {
+			return nil, status.Error(codes.FailedPrecondition, "Calendar feeds are disabled")
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to create feed token")
+	}
+
+	return &eventspb.CreateFeedTokenResponse{Token: token, Path: server.ICalFeedPath(token)}, nil
+}
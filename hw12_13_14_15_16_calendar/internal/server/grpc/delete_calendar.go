@@ -0,0 +1,36 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeleteCalendar имплементация grpc метода DeleteCalendar.
+func (i *Implementation) DeleteCalendar(
+	ctx context.Context,
+	req *eventspb.DeleteCalendarRequest,
+) (*eventspb.DeleteCalendarResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = i.app.DeleteCalendar(ctx, userID, req.Id); err != nil {
+		if errors.Is(err, app.ErrInvalidCalendar) {
+			return nil, status.Error(codes.FailedPrecondition, "Default calendar can't be deleted")
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to delete calendar")
+	}
+
+	return &eventspb.DeleteCalendarResponse{}, nil
+}
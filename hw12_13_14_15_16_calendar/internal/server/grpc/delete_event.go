@@ -13,7 +13,16 @@ func (i *Implementation) DeleteEvent(
 	ctx context.Context,
 	req *eventspb.DeleteEventRequest,
 ) (*eventspb.DeleteEventResponse, error) {
-	if err := i.app.DeleteEvent(ctx, req.Id); err != nil {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = i.app.DeleteEvent(ctx, userID, req.Id); err != nil {
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
 		return nil, status.Error(codes.Internal, "Failed to delete event")
 	}
 
@@ -0,0 +1,49 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// permissions соответствие уровней доступа grpc модели уровням доступа хранилища.
+var permissions = map[eventspb.Permission]storage.Permission{
+	eventspb.Permission_PERMISSION_FREE_BUSY: storage.PermissionFreeBusy,
+	eventspb.Permission_PERMISSION_READ:      storage.PermissionRead,
+	eventspb.Permission_PERMISSION_WRITE:     storage.PermissionWrite,
+}
+
+// GrantAccess имплементация grpc метода GrantAccess.
+func (i *Implementation) GrantAccess(
+	ctx context.Context,
+	req *eventspb.GrantAccessRequest,
+) (*eventspb.GrantAccessResponse, error) {
+	ownerID, err := requestUser(ctx, req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	permission, ok := permissions[req.Permission]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown permission %v", req.Permission)
+	}
+
+	if err = i.app.GrantAccess(ctx, ownerID, req.GranteeId, permission); err != nil {
+		if errors.Is(err, app.ErrInvalidGrant) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid grant: %v", err)
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to grant access")
+	}
+
+	return &eventspb.GrantAccessResponse{}, nil
+}
@@ -0,0 +1,65 @@
+package internalgrpc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var importStatuses = map[app.ImportStatus]eventspb.ImportEventsResponse_Status{
+	app.ImportStatusCreated: eventspb.ImportEventsResponse_STATUS_CREATED,
+	app.ImportStatusSkipped: eventspb.ImportEventsResponse_STATUS_SKIPPED,
+	app.ImportStatusFailed:  eventspb.ImportEventsResponse_STATUS_FAILED,
+}
+
+// ImportEvents имплементация grpc метода ImportEvents.
+func (i *Implementation) ImportEvents(
+	ctx context.Context,
+	req *eventspb.ImportEventsRequest,
+) (*eventspb.ImportEventsResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := i.app.ImportEvents(ctx, userID, req.CalendarId, bytes.NewReader(req.Ics))
+	if err != nil {
+		if errors.Is(err, app.ErrInvalidICalendar) {
+			return nil, status.Errorf(codes.InvalidArgument, "Failed to parse calendar: %v", err)
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to import events")
+	}
+
+	var response eventspb.ImportEventsResponse
+
+	for _, result := range results {
+		item := &eventspb.ImportEventsResponse_Result{
+			Uid:    result.UID,
+			Status: importStatuses[result.Status],
+		}
+
+		switch result.Status {
+		case app.ImportStatusCreated:
+			response.Created++
+		case app.ImportStatusSkipped:
+			response.Skipped++
+		case app.ImportStatusFailed:
+			response.Failed++
+			item.Error = result.Err.Error()
+		}
+
+		response.Results = append(response.Results, item)
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,74 @@
+package interceptors
+
+import (
+	"context"
+	"strings"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ITokenVerifier интерфейс проверки токена, возвращающей идентификатор пользователя.
+type ITokenVerifier interface {
+	Verify(token string) (string, error)
+}
+
+// NewUnaryServerAuthInterceptor создает серверный интерсептор, проверяющий bearer JWT из метаданных
+// authorization и помещающий subject токена в контекст (см. auth.SubjectFromContext).
+func NewUnaryServerAuthInterceptor(verifier ITokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// NewStreamServerAuthInterceptor создает серверный интерсептор потоковых RPC, аналогичный
+// NewUnaryServerAuthInterceptor.
+func NewStreamServerAuthInterceptor(verifier ITokenVerifier) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(stream.Context(), verifier)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticatedStream подменяет контекст потока контекстом с subject.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func authenticate(ctx context.Context, verifier ITokenVerifier) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "Bearer token is required")
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return nil, status.Error(codes.Unauthenticated, "Bearer token is required")
+	}
+
+	subject, err := verifier.Verify(token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+
+	return auth.WithSubject(ctx, subject), nil
+}
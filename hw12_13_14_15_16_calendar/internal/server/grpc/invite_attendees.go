@@ -0,0 +1,49 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// attendeeRoles соответствие ролей участников grpc модели ролям хранилища.
+var attendeeRoles = map[eventspb.AttendeeRole]storage.AttendeeRole{
+	eventspb.AttendeeRole_ATTENDEE_ROLE_UNSPECIFIED: storage.AttendeeRoleRequired,
+	eventspb.AttendeeRole_ATTENDEE_ROLE_REQUIRED:    storage.AttendeeRoleRequired,
+	eventspb.AttendeeRole_ATTENDEE_ROLE_OPTIONAL:    storage.AttendeeRoleOptional,
+}
+
+// InviteAttendees имплементация grpc метода InviteAttendees.
+func (i *Implementation) InviteAttendees(
+	ctx context.Context,
+	req *eventspb.InviteAttendeesRequest,
+) (*eventspb.InviteAttendeesResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	role, ok := attendeeRoles[req.Role]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown role %v", req.Role)
+	}
+
+	if err = i.app.InviteAttendees(ctx, userID, req.EventId, req.AttendeeIds, role); err != nil {
+		if errors.Is(err, app.ErrInvalidInvitation) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid invitation: %v", err)
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to invite attendees")
+	}
+
+	return &eventspb.InviteAttendeesResponse{}, nil
+}
@@ -0,0 +1,31 @@
+package internalgrpc
+
+import (
+	"context"
+
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListCalendars имплементация grpc метода ListCalendars.
+func (i *Implementation) ListCalendars(
+	ctx context.Context,
+	req *eventspb.ListCalendarsRequest,
+) (*eventspb.ListCalendarsResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, err := i.app.ListCalendars(ctx, userID)
+	if err != nil {
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to list calendars")
+	}
+
+	return &eventspb.ListCalendarsResponse{Calendars: toPBCalendars(calendars)}, nil
+}
@@ -0,0 +1,65 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// orders соответствие порядков сортировки grpc модели порядкам хранилища.
+var orders = map[eventspb.ListEventsRequest_Order]storage.SortOrder{
+	eventspb.ListEventsRequest_ORDER_UNSPECIFIED: storage.SortOrderAsc,
+	eventspb.ListEventsRequest_ORDER_ASC:         storage.SortOrderAsc,
+	eventspb.ListEventsRequest_ORDER_DESC:        storage.SortOrderDesc,
+}
+
+// ListEvents имплементация grpc метода ListEvents.
+func (i *Implementation) ListEvents(
+	ctx context.Context,
+	req *eventspb.ListEventsRequest,
+) (*eventspb.ListEventsResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	order, ok := orders[req.Order]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown order %v", req.Order)
+	}
+
+	page, err := i.app.ListEvents(ctx, &app.ListEventsParams{
+		UserID:      userID,
+		CalendarIDs: req.CalendarIds,
+		From:        fromPBOptionalTimestamp(req.From),
+		To:          fromPBOptionalTimestamp(req.To),
+		Query:       req.Query,
+		Order:       order,
+		PageSize:    int(req.PageSize),
+		PageToken:   req.PageToken,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, app.ErrInvalidRange):
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid time range: %v", err)
+		case errors.Is(err, app.ErrInvalidPageToken):
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token")
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to list events")
+	}
+
+	return &eventspb.ListEventsResponse{
+		Events:        toPBEvents(page.Events),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
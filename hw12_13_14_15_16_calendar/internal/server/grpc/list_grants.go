@@ -0,0 +1,31 @@
+package internalgrpc
+
+import (
+	"context"
+
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListGrants имплементация grpc метода ListGrants.
+func (i *Implementation) ListGrants(
+	ctx context.Context,
+	req *eventspb.ListGrantsRequest,
+) (*eventspb.ListGrantsResponse, error) {
+	ownerID, err := requestUser(ctx, req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := i.app.ListGrants(ctx, ownerID)
+	if err != nil {
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to list grants")
+	}
+
+	return &eventspb.ListGrantsResponse{Grants: toPBGrants(grants)}, nil
+}
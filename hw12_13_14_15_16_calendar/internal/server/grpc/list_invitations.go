@@ -0,0 +1,31 @@
+package internalgrpc
+
+import (
+	"context"
+
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListInvitations имплементация grpc метода ListInvitations.
+func (i *Implementation) ListInvitations(
+	ctx context.Context,
+	req *eventspb.ListInvitationsRequest,
+) (*eventspb.ListInvitationsResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := i.app.ListInvitations(ctx, userID)
+	if err != nil {
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to list invitations")
+	}
+
+	return &eventspb.ListInvitationsResponse{Events: toPBEvents(events)}, nil
+}
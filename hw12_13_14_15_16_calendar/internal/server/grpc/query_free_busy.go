@@ -0,0 +1,53 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// QueryFreeBusy имплементация grpc метода QueryFreeBusy.
+func (i *Implementation) QueryFreeBusy(
+	ctx context.Context,
+	req *eventspb.QueryFreeBusyRequest,
+) (*eventspb.QueryFreeBusyResponse, error) {
+	freeBusy, err := i.app.QueryFreeBusy(ctx, req.UserIds, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		if errors.Is(err, app.ErrInvalidRange) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid free/busy query: %v", err)
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to query free/busy")
+	}
+
+	response := eventspb.QueryFreeBusyResponse{
+		Users: make([]*eventspb.QueryFreeBusyResponse_User, 0, len(freeBusy)),
+	}
+
+	for _, user := range freeBusy {
+		item := &eventspb.QueryFreeBusyResponse_User{
+			UserId: user.UserID,
+			Busy:   make([]*eventspb.QueryFreeBusyResponse_Interval, 0, len(user.Busy)),
+		}
+
+		for _, interval := range user.Busy {
+			item.Busy = append(item.Busy, &eventspb.QueryFreeBusyResponse_Interval{
+				Start: timestamppb.New(interval.Start),
+				End:   timestamppb.New(interval.End),
+			})
+		}
+
+		response.Users = append(response.Users, item)
+	}
+
+	return &response, nil
+}
@@ -3,11 +3,11 @@ package internalgrpc
 import (
 	"context"
 
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
 	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/durationpb"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ReadMonthlyEvents имплементация grpc метода ReadMonthlyEvents.
@@ -15,24 +15,23 @@ func (i *Implementation) ReadMonthlyEvents(
 	ctx context.Context,
 	req *eventspb.ReadMonthlyEventsRequest,
 ) (*eventspb.ReadMonthlyEventsResponse, error) {
-	events, err := i.app.ReadMonthlyEvents(ctx, req.UserId, req.Date.AsTime())
+	userID, err := requestUser(ctx, req.UserId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Failed to get monthly events")
+		return nil, err
 	}
 
-	var response eventspb.ReadMonthlyEventsResponse
+	events, err := i.app.ReadMonthlyEvents(ctx, userID, req.Date.AsTime(), req.TimeZone, req.CalendarIds)
+	if err != nil {
+		if errors.Is(err, app.ErrInvalidTimeZone) {
+			return nil, status.Error(codes.InvalidArgument, "Invalid time zone")
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
 
-	for _, event := range events {
-		response.Events = append(response.Events, &eventspb.Event{
-			Id:             event.ID,
-			Title:          event.Title,
-			StartsAt:       timestamppb.New(*event.StartsAt),
-			EndsAt:         timestamppb.New(*event.EndsAt),
-			Description:    event.Description,
-			UserId:         event.UserID,
-			NotifyInterval: durationpb.New(event.NotifyInterval),
-		})
+		return nil, status.Error(codes.Internal, "Failed to get monthly events")
 	}
 
-	return &response, nil
+	return &eventspb.ReadMonthlyEventsResponse{Events: toPBEvents(events)}, nil
 }
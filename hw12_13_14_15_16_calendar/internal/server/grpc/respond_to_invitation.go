@@ -0,0 +1,49 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rsvpResponses соответствие ответов на приглашение grpc модели ответам хранилища.
+var rsvpResponses = map[eventspb.RSVPStatus]storage.RSVPStatus{
+	eventspb.RSVPStatus_RSVP_STATUS_ACCEPTED:  storage.RSVPStatusAccepted,
+	eventspb.RSVPStatus_RSVP_STATUS_DECLINED:  storage.RSVPStatusDeclined,
+	eventspb.RSVPStatus_RSVP_STATUS_TENTATIVE: storage.RSVPStatusTentative,
+}
+
+// RespondToInvitation имплементация grpc метода RespondToInvitation.
+func (i *Implementation) RespondToInvitation(
+	ctx context.Context,
+	req *eventspb.RespondToInvitationRequest,
+) (*eventspb.RespondToInvitationResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	response, ok := rsvpResponses[req.Status]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unsupported response %v", req.Status)
+	}
+
+	if err = i.app.RespondToInvitation(ctx, userID, req.EventId, response); err != nil {
+		if errors.Is(err, app.ErrInvitationNotFound) {
+			return nil, status.Error(codes.NotFound, "Invitation not found")
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to respond to invitation")
+	}
+
+	return &eventspb.RespondToInvitationResponse{}, nil
+}
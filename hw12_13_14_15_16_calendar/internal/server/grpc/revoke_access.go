@@ -0,0 +1,30 @@
+package internalgrpc
+
+import (
+	"context"
+
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RevokeAccess имплементация grpc метода RevokeAccess.
+func (i *Implementation) RevokeAccess(
+	ctx context.Context,
+	req *eventspb.RevokeAccessRequest,
+) (*eventspb.RevokeAccessResponse, error) {
+	ownerID, err := requestUser(ctx, req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = i.app.RevokeAccess(ctx, ownerID, req.GranteeId); err != nil {
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to revoke access")
+	}
+
+	return &eventspb.RevokeAccessResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchEvents имплементация grpc метода SearchEvents.
+func (i *Implementation) SearchEvents(
+	ctx context.Context,
+	req *eventspb.SearchEventsRequest,
+) (*eventspb.SearchEventsResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := i.app.SearchEvents(ctx, userID, req.Query, req.CalendarIds)
+	if err != nil {
+		if errors.Is(err, app.ErrEmptyQuery) {
+			return nil, status.Error(codes.InvalidArgument, "Search query is required")
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to search events")
+	}
+
+	return &eventspb.SearchEventsResponse{Events: toPBEvents(events)}, nil
+}
@@ -3,6 +3,7 @@ package internalgrpc
 import (
 	syslog "log"
 	"net"
+	"time"
 
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
 	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
@@ -12,15 +13,29 @@ import (
 	"google.golang.org/grpc"
 )
 
+// stopTimeout время ожидания завершения вызовов при остановке сервера.
+const stopTimeout = 3 * time.Second
+
 // Server представляет grpc сервер приложения.
 type Server struct {
 	server *grpc.Server
 }
 
-// NewServer конструктор для grpc сервера.
-func NewServer(logger *syslog.Logger, app app.IApp) *Server {
+// NewServer конструктор для grpc сервера. Если verifier не nil, вызовы требуют bearer JWT,
+// и пользователь определяется по токену.
+func NewServer(logger *syslog.Logger, app app.IApp, verifier interceptors.ITokenVerifier) *Server {
+	unary := []grpc.UnaryServerInterceptor{interceptors.NewUnaryServerLoggingInterceptor(logger)}
+
+	var stream []grpc.StreamServerInterceptor
+
+	if verifier != nil {
+		unary = append(unary, interceptors.NewUnaryServerAuthInterceptor(verifier))
+		stream = append(stream, interceptors.NewStreamServerAuthInterceptor(verifier))
+	}
+
 	server := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(interceptors.NewUnaryServerLoggingInterceptor(logger)),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
 	)
 	impl := NewEventsServer(app)
 	eventspb.RegisterEventsServer(server, impl)
@@ -44,8 +59,21 @@ func (s *Server) Start(addr string) error {
 	return nil
 }
 
-// Stop останавливает grpc сервер с поддержкой graceful shutdown.
+// Stop останавливает grpc сервер с поддержкой graceful shutdown. Потоковые вызовы (WatchEvents)
+// не завершаются сами, поэтому по истечении stopTimeout они обрываются.
 func (s *Server) Stop() {
-	s.server.GracefulStop()
-	log.Info().Msg("calendar GRPC gracefully shutdown")
+	stopped := make(chan struct{})
+
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		log.Info().Msg("calendar GRPC gracefully shutdown")
+	case <-time.After(stopTimeout):
+		s.server.Stop()
+		log.Warn().Msg("calendar GRPC forcibly shutdown after timeout")
+	}
 }
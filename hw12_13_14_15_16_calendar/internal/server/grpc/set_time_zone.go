@@ -0,0 +1,36 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetTimeZone имплементация grpc метода SetTimeZone.
+func (i *Implementation) SetTimeZone(
+	ctx context.Context,
+	req *eventspb.SetTimeZoneRequest,
+) (*eventspb.SetTimeZoneResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = i.app.SetTimeZone(ctx, userID, req.TimeZone); err != nil {
+		if errors.Is(err, app.ErrInvalidTimeZone) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid time zone %q", req.TimeZone)
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to set time zone")
+	}
+
+	return &eventspb.SetTimeZoneResponse{}, nil
+}
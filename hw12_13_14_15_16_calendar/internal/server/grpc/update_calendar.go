@@ -0,0 +1,43 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateCalendar имплементация grpc метода UpdateCalendar.
+func (i *Implementation) UpdateCalendar(
+	ctx context.Context,
+	req *eventspb.UpdateCalendarRequest,
+) (*eventspb.UpdateCalendarResponse, error) {
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = i.app.UpdateCalendar(
+		ctx,
+		userID,
+		req.Id,
+		req.Name,
+		req.Color,
+		req.DefaultNotifyInterval.AsDuration(),
+	); err != nil {
+		if errors.Is(err, app.ErrInvalidCalendar) {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid calendar: %v", err)
+		}
+
+		if st, ok := accessStatus(err); ok {
+			return nil, st.Err()
+		}
+
+		return nil, status.Error(codes.Internal, "Failed to update calendar")
+	}
+
+	return &eventspb.UpdateCalendarResponse{}, nil
+}
@@ -0,0 +1,42 @@
+package internalgrpc
+
+import (
+	"context"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requestUser возвращает пользователя, к календарю которого обращается вызов: requested из запроса,
+// а если он не задан - subject токена. Права вызывающего на этот календарь проверяет приложение.
+func requestUser(ctx context.Context, requested string) (string, error) {
+	if requested != "" {
+		return requested, nil
+	}
+
+	if subject, ok := auth.SubjectFromContext(ctx); ok {
+		return subject, nil
+	}
+
+	return "", status.Error(codes.InvalidArgument, "User ID is required")
+}
+
+// accessStatus возвращает grpc статус для ошибок доступа: отсутствующих события, календаря или разрешения
+// и обращения к календарю, на которое у пользователя нет прав.
+func accessStatus(err error) (*status.Status, bool) {
+	switch {
+	case errors.Is(err, app.ErrEventNotFound):
+		return status.New(codes.NotFound, "Event not found"), true
+	case errors.Is(err, app.ErrCalendarNotFound):
+		return status.New(codes.NotFound, "Calendar not found"), true
+	case errors.Is(err, app.ErrGrantNotFound):
+		return status.New(codes.NotFound, "Grant not found"), true
+	case errors.Is(err, app.ErrPermissionDenied):
+		return status.New(codes.PermissionDenied, "Access to the calendar is denied"), true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,47 @@
+package internalgrpc
+
+import (
+	eventspb "github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pb/events"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchEvents имплементация grpc метода WatchEvents. Поток завершается с кодом ResourceExhausted,
+// если клиент не успевает читать изменения; клиенту следует перечитать события и подписаться заново.
+func (i *Implementation) WatchEvents(
+	req *eventspb.WatchEventsRequest,
+	stream grpc.ServerStreamingServer[eventspb.EventChange],
+) error {
+	ctx := stream.Context()
+
+	userID, err := requestUser(ctx, req.UserId)
+	if err != nil {
+		return err
+	}
+
+	changes, err := i.app.WatchEvents(ctx, userID)
+	if err != nil {
+		if st, ok := accessStatus(err); ok {
+			return st.Err()
+		}
+
+		return status.Error(codes.Internal, "Failed to watch events")
+	}
+
+	for change := range changes {
+		if !change.Event.InCalendars(req.CalendarIds) {
+			continue
+		}
+
+		if err = stream.Send(toPBEventChange(change)); err != nil {
+			return err
+		}
+	}
+
+	if err = ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	return status.Error(codes.ResourceExhausted, "Watcher is too slow, resubscribe")
+}
@@ -0,0 +1,123 @@
+package feed
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/ical"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Окно событий, попадающих в ленту, в месяцах относительно текущего.
+const (
+	monthsBefore = 1
+	monthsAfter  = 12
+)
+
+const prodID = "-//devgomax//calendar//RU"
+
+// NewHandler создает http обработчик iCalendar-ленты событий пользователя, доступной по секретному токену.
+func NewHandler(a app.IApp) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := a.ResolveFeedToken(r.Context(), chi.URLParam(r, "token"))
+		if err != nil {
+			switch {
+			case errors.Is(err, app.ErrInvalidFeedToken):
+				http.NotFound(w, r)
+			case errors.Is(err, app.ErrFeedsDisabled):
+				http.Error(w, "calendar feeds are disabled", http.StatusNotImplemented)
+			default:
+				log.Error().Err(err).Msg("[feed::Handler]: failed to resolve feed token")
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+
+			return
+		}
+
+		now := time.Now().UTC()
+
+		events, err := readEvents(r, a, userID, now)
+		if err != nil {
+			log.Error().Err(err).Str("user_id", userID).Msg("[feed::Handler]: failed to read events")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		cal := ical.Calendar{
+			ProdID: prodID,
+			Name:   "Calendar",
+			Events: make([]ical.Event, 0, len(events)),
+		}
+
+		for _, event := range events {
+			cal.Events = append(cal.Events, toICalEvent(event, now))
+		}
+
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Content-Disposition", `inline; filename="calendar.ics"`)
+
+		if err = ical.Encode(w, &cal); err != nil {
+			log.Error().Err(err).Str("user_id", userID).Msg("[feed::Handler]: failed to write calendar")
+		}
+	}
+}
+
+// readEvents читает события пользователя помесячно в окне ленты, убирая пересечения соседних месяцев.
+func readEvents(r *http.Request, a app.IApp, userID string, now time.Time) ([]*storage.Event, error) {
+	type key struct {
+		id    string
+		start time.Time
+	}
+
+	seen := make(map[key]struct{})
+
+	var result []*storage.Event
+
+	for month := -monthsBefore; month <= monthsAfter; month++ {
+		date := time.Date(now.Year(), now.Month()+time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+
+		events, err := a.ReadMonthlyEvents(r.Context(), userID, date, "", nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "[feed::readEvents]")
+		}
+
+		for _, event := range events {
+			k := key{id: event.ID, start: event.StartsAt.UTC()}
+			if _, ok := seen[k]; ok {
+				continue
+			}
+
+			seen[k] = struct{}{}
+			result = append(result, event)
+		}
+	}
+
+	return result, nil
+}
+
+// toICalEvent конвертирует событие (или вхождение повторяющегося события) в VEVENT.
+// Импортированные события сохраняют исходный UID, вхождения публикуются как отдельные события.
+func toICalEvent(event *storage.Event, now time.Time) ical.Event {
+	uid := event.ID + "@calendar"
+	if event.ICalUID != "" {
+		uid = event.ICalUID
+	}
+
+	if event.IsRecurring() {
+		uid = event.StartsAt.UTC().Format("20060102T150405Z") + "-" + uid
+	}
+
+	return ical.Event{
+		UID:         uid,
+		Stamp:       now,
+		Start:       *event.StartsAt,
+		End:         *event.EndsAt,
+		Summary:     event.Title,
+		Description: event.Description,
+		Alarm:       event.NotifyInterval,
+	}
+}
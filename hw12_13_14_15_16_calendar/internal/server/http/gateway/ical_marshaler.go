@@ -0,0 +1,47 @@
+package gateway
+
+import (
+	"io"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MIMEICal MIME тип iCalendar данных.
+const MIMEICal = "text/calendar"
+
+// ICalMarshaler маршалер grpc-gateway, принимающий "сырой" iCalendar файл в теле запроса
+// (для методов с body, указывающим на bytes поле) и отвечающий в JSON.
+type ICalMarshaler struct {
+	runtime.JSONPb
+}
+
+// NewICalMarshaler конструктор маршалера для iCalendar загрузок.
+func NewICalMarshaler() *ICalMarshaler {
+	return &ICalMarshaler{
+		JSONPb: runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}
+}
+
+// NewDecoder возвращает декодер, читающий тело запроса целиком в []byte поле.
+func (m *ICalMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v any) error {
+		dst, ok := v.(*[]byte)
+		if !ok {
+			return errors.Errorf("[gateway::ICalMarshaler]: unsupported target %T for %s body", v, MIMEICal)
+		}
+
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return errors.Wrap(err, "[gateway::ICalMarshaler]: can't read body")
+		}
+
+		*dst = data
+
+		return nil
+	})
+}
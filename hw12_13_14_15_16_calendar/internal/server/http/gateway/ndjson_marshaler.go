@@ -0,0 +1,30 @@
+package gateway
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MIMENDJSON MIME тип потока JSON объектов, разделенных переводом строки.
+const MIMENDJSON = "application/x-ndjson"
+
+// NDJSONMarshaler маршалер grpc-gateway для потоковых методов: каждое сообщение потока
+// записывается отдельной JSON строкой. Выбирается заголовком "Accept: application/x-ndjson".
+type NDJSONMarshaler struct {
+	runtime.JSONPb
+}
+
+// NewNDJSONMarshaler конструктор маршалера для потоковых ответов.
+func NewNDJSONMarshaler() *NDJSONMarshaler {
+	return &NDJSONMarshaler{
+		JSONPb: runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}
+}
+
+// ContentType возвращает MIME тип ответа.
+func (m *NDJSONMarshaler) ContentType(_ any) string {
+	return MIMENDJSON
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+)
+
+// AccessTokenParam параметр запроса с bearer токеном для клиентов, которые не могут передать
+// заголовок Authorization (браузерный EventSource).
+const AccessTokenParam = "access_token"
+
+// ITokenVerifier интерфейс проверки токена, возвращающей идентификатор пользователя.
+type ITokenVerifier interface {
+	Verify(token string) (string, error)
+}
+
+// NewAuthMiddleware создает middleware, проверяющий bearer JWT из заголовка Authorization
+// или параметра AccessTokenParam и помещающий subject токена в контекст (см. auth.SubjectFromContext).
+// Запросы без действительного токена отклоняются с кодом 401.
+func NewAuthMiddleware(verifier ITokenVerifier) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token := bearerToken(r)
+			if token == "" {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				http.Error(w, "bearer token is required", http.StatusUnauthorized)
+				return
+			}
+
+			subject, err := verifier.Verify(token)
+			if err != nil {
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				http.Error(w, "invalid token", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.WithSubject(r.Context(), subject)))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); header != "" {
+		scheme, token, ok := strings.Cut(header, " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") {
+			return ""
+		}
+
+		return token
+	}
+
+	return r.URL.Query().Get(AccessTokenParam)
+}
+
+// redactedURI возвращает путь запроса, в котором значение AccessTokenParam скрыто.
+func redactedURI(u *url.URL) string {
+	query := u.Query()
+	if !query.Has(AccessTokenParam) {
+		return u.RequestURI()
+	}
+
+	query.Set(AccessTokenParam, "REDACTED")
+
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+
+	return redacted.RequestURI()
+}
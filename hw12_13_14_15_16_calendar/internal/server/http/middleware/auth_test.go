@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	"github.com/stretchr/testify/require"
+)
+
+type staticVerifier map[string]string
+
+func (v staticVerifier) Verify(token string) (string, error) {
+	subject, ok := v[token]
+	if !ok {
+		return "", errors.New("unknown token")
+	}
+
+	return subject, nil
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	handler := NewAuthMiddleware(staticVerifier{"valid": "alice"})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			subject, _ := auth.SubjectFromContext(r.Context())
+			_, _ = w.Write([]byte(subject))
+		}))
+
+	serve := func(target, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := serve("/stream", "Bearer valid")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "alice", rec.Body.String())
+
+	rec = serve("/stream?access_token=valid", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "alice", rec.Body.String())
+
+	require.Equal(t, http.StatusUnauthorized, serve("/stream?user_id=alice", "").Code)
+	require.Equal(t, http.StatusUnauthorized, serve("/stream", "Bearer forged").Code)
+	require.Equal(t, http.StatusUnauthorized, serve("/stream", "Basic valid").Code)
+}
+
+func TestRedactedURI(t *testing.T) {
+	u, err := url.Parse("/v1/reminders/stream?access_token=secret&x=1")
+	require.NoError(t, err)
+
+	require.NotContains(t, redactedURI(u), "secret")
+	require.Contains(t, redactedURI(u), "x=1")
+}
@@ -18,7 +18,7 @@ func NewLoggingMiddleware(logger *log.Logger) func(next http.Handler) http.Handl
 			ip := strings.Split(r.RemoteAddr, ":")[0]
 			ts := start.Format(server.LogTimestampFormat)
 			method := r.Method
-			path := r.URL.RequestURI()
+			path := redactedURI(r.URL)
 			userAgent := r.UserAgent()
 
 			rl := &responseWriterProxy{ResponseWriter: w, statusCode: http.StatusOK}
@@ -44,3 +44,9 @@ func (rw *responseWriterProxy) WriteHeader(code int) {
 	rw.statusCode = code
 	rw.ResponseWriter.WriteHeader(code)
 }
+
+// Unwrap возвращает исходный http.ResponseWriter, чтобы http.ResponseController мог сбрасывать
+// буфер потоковых ответов (WatchEvents).
+func (rw *responseWriterProxy) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
@@ -0,0 +1,142 @@
+package reminders
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/auth"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// defaultHeartbeat период комментариев, поддерживающих соединение, если он не задан.
+const defaultHeartbeat = 15 * time.Second
+
+// Типы SSE событий потока напоминаний.
+const (
+	eventReminder = "reminder"
+	// eventReset сообщает, что часть напоминаний после Last-Event-ID уже вытеснена из backlog
+	eventReset = "reset"
+)
+
+// NewStreamHandler создает http обработчик SSE потока напоминаний аутентифицированного пользователя,
+// subject которого помещен в контекст запроса (см. middleware.NewAuthMiddleware). Без заголовка
+// Last-Event-ID поток начинается с новых напоминаний, иначе сначала передаются напоминания из backlog,
+// пропущенные клиентом.
+func NewStreamHandler(hub *Hub, heartbeat time.Duration) http.HandlerFunc {
+	if heartbeat <= 0 {
+		heartbeat = defaultHeartbeat
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := auth.SubjectFromContext(r.Context())
+		if !ok {
+			http.Error(w, "authentication is required", http.StatusUnauthorized)
+			return
+		}
+
+		lastEventID := r.Header.Get("Last-Event-ID")
+
+		var lastID uint64
+		if lastEventID != "" {
+			var err error
+			if lastID, err = strconv.ParseUint(lastEventID, 10, 64); err != nil {
+				http.Error(w, "invalid Last-Event-ID", http.StatusBadRequest)
+				return
+			}
+		}
+
+		missed, complete, sub := hub.subscribe(userID, lastID)
+		defer hub.unsubscribe(userID, sub)
+
+		rc := http.NewResponseController(w)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		stream := &sseWriter{w: w, rc: rc}
+
+		if lastEventID != "" {
+			if !complete {
+				stream.event("", eventReset, []byte("{}"))
+			}
+
+			for _, e := range missed {
+				stream.reminder(e)
+			}
+		}
+
+		stream.flush()
+
+		ticker := time.NewTicker(heartbeat)
+		defer ticker.Stop()
+
+		for stream.err == nil {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-ticker.C:
+				stream.comment("heartbeat")
+			case e, ok := <-sub:
+				if !ok {
+					// клиент не успевает читать напоминания - EventSource переподключится с Last-Event-ID
+					return
+				}
+				stream.reminder(e)
+			}
+
+			stream.flush()
+		}
+
+		log.Debug().Err(stream.err).Str("user_id", userID).Msg("[reminders::Stream]: failed to write to client")
+	}
+}
+
+// sseWriter пишет SSE события, запоминая первую ошибку записи.
+type sseWriter struct {
+	w   http.ResponseWriter
+	rc  *http.ResponseController
+	err error
+}
+
+func (s *sseWriter) reminder(e *entry) {
+	data, err := json.Marshal(e.notification)
+	if err != nil {
+		s.err = errors.Wrap(err, "can't marshal reminder")
+		return
+	}
+
+	s.event(strconv.FormatUint(e.id, 10), eventReminder, data)
+}
+
+func (s *sseWriter) event(id, name string, data []byte) {
+	if s.err != nil {
+		return
+	}
+
+	if id != "" {
+		_, s.err = fmt.Fprintf(s.w, "id: %s\n", id)
+	}
+
+	if s.err == nil {
+		_, s.err = fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", name, data)
+	}
+}
+
+func (s *sseWriter) comment(text string) {
+	if s.err == nil {
+		_, s.err = fmt.Fprintf(s.w, ": %s\n\n", text)
+	}
+}
+
+func (s *sseWriter) flush() {
+	if s.err == nil {
+		s.err = s.rc.Flush()
+	}
+}
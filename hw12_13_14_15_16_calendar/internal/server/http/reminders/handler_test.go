@@ -0,0 +1,184 @@
+package reminders
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender/notifiers"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/server/http/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+type sseEvent struct {
+	id   string
+	name string
+	data string
+}
+
+// readEvent читает следующее SSE событие, пропуская комментарии.
+func readEvent(t *testing.T, r *bufio.Reader) sseEvent {
+	t.Helper()
+
+	var event sseEvent
+
+	for {
+		line, err := r.ReadString('\n')
+		require.NoError(t, err)
+
+		line = strings.TrimSuffix(line, "\n")
+
+		switch {
+		case line == "" && event.name != "":
+			return event
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+// subjectVerifier считает токен идентификатором пользователя.
+type subjectVerifier struct{}
+
+func (subjectVerifier) Verify(token string) (string, error) {
+	return token, nil
+}
+
+func connect(t *testing.T, ctx context.Context, url, lastEventID string) *bufio.Reader {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer user")
+
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { resp.Body.Close() })
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	return bufio.NewReader(resp.Body)
+}
+
+func notification(title string) *app.EventNotification {
+	return &app.EventNotification{
+		EventID:    title,
+		EventTitle: title,
+		EventDate:  time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+		UserID:     "user",
+	}
+}
+
+func TestStreamHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	hub := NewHub(2, time.Hour)
+	srv := httptest.NewServer(middleware.NewAuthMiddleware(subjectVerifier{})(NewStreamHandler(hub, 10*time.Millisecond)))
+	t.Cleanup(srv.Close)
+
+	stream := connect(t, ctx, srv.URL, "")
+
+	require.NoError(t, hub.Notify(ctx, notification("first")))
+	// повторная доставка того же напоминания игнорируется
+	require.NoError(t, hub.Notify(ctx, notification("first")))
+	require.NoError(t, hub.Notify(ctx, notification("second")))
+
+	first := readEvent(t, stream)
+	require.Equal(t, eventReminder, first.name)
+
+	var received app.EventNotification
+	require.NoError(t, json.Unmarshal([]byte(first.data), &received))
+	require.Equal(t, "first", received.EventTitle)
+
+	second := readEvent(t, stream)
+	require.Contains(t, second.data, `"second"`)
+
+	t.Run("resume after Last-Event-ID", func(t *testing.T) {
+		resumed := connect(t, ctx, srv.URL, first.id)
+		require.Equal(t, second, readEvent(t, resumed))
+	})
+
+	t.Run("reset when backlog was evicted", func(t *testing.T) {
+		require.NoError(t, hub.Notify(ctx, notification("third")))
+		require.NoError(t, hub.Notify(ctx, notification("fourth")))
+
+		resumed := connect(t, ctx, srv.URL, first.id)
+		require.Equal(t, eventReset, readEvent(t, resumed).name)
+		require.Contains(t, readEvent(t, resumed).data, `"third"`)
+		require.Contains(t, readEvent(t, resumed).data, `"fourth"`)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"?user_id=user", nil)
+		require.NoError(t, err)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("heartbeat", func(t *testing.T) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"?access_token=idle", nil)
+		require.NoError(t, err)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		line, err := bufio.NewReader(resp.Body).ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, ": heartbeat\n", line)
+	})
+}
+
+func TestIngestHandler(t *testing.T) {
+	hub := NewHub(10, time.Hour)
+	handler := NewIngestHandler(hub, "secret")
+
+	body, err := json.Marshal(notification("ingested"))
+	require.NoError(t, err)
+
+	post := func(secret string) int {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+		req := httptest.NewRequest(http.MethodPost, "/internal/reminders", bytes.NewReader(body))
+		req.Header.Set(notifiers.HeaderTimestamp, timestamp)
+		req.Header.Set(notifiers.HeaderSignature, notifiers.Sign(secret, timestamp, body))
+
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+
+		return rec.Code
+	}
+
+	require.Equal(t, http.StatusUnauthorized, post("wrong"))
+	require.Equal(t, http.StatusNoContent, post("secret"))
+
+	missed, complete, sub := hub.subscribe("user", 0)
+	defer hub.unsubscribe("user", sub)
+
+	require.True(t, complete)
+	require.Len(t, missed, 1)
+	require.Equal(t, "ingested", missed[0].notification.EventTitle)
+}
@@ -0,0 +1,198 @@
+// Package reminders реализует SSE поток доставленных напоминаний пользователя.
+package reminders
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender/notifiers"
+)
+
+// Значения по умолчанию для Hub.
+const (
+	defaultBacklog    = 100
+	defaultBacklogTTL = time.Hour
+	// subscriberBuffer количество напоминаний, которые подписчик может не успеть прочитать;
+	// при переполнении подписчик отключается и возобновляет поток по Last-Event-ID.
+	subscriberBuffer = 16
+)
+
+// entry напоминание с порядковым номером, используемым как id SSE события.
+type entry struct {
+	id           uint64
+	key          string
+	notification *app.EventNotification
+	at           time.Time
+}
+
+// userStream хранит последние напоминания пользователя и его подписчиков.
+type userStream struct {
+	backlog []*entry
+	// evicted номер последнего вытесненного из backlog напоминания
+	evicted     uint64
+	subscribers map[chan *entry]struct{}
+}
+
+// Hub рассылает доставленные напоминания подписчикам и хранит ограниченный backlog
+// последних напоминаний каждого пользователя для возобновления потока. Реализует sender.INotifier,
+// поэтому может использоваться как канал доставки рассыльщика внутри процесса.
+//
+// Напоминания хранятся в backlog не дольше ttl (фактически до двух ttl, см. prune), а поток
+// пользователя без подписчиков удаляется, как только его backlog опустеет.
+type Hub struct {
+	mu       sync.Mutex
+	seq      uint64
+	backlog  int
+	ttl      time.Duration
+	now      func() time.Time
+	prunedAt time.Time
+	users    map[string]*userStream
+}
+
+// NewHub конструктор хаба напоминаний, хранящего до backlog напоминаний каждого пользователя
+// в течение ttl.
+func NewHub(backlog int, ttl time.Duration) *Hub {
+	if backlog <= 0 {
+		backlog = defaultBacklog
+	}
+
+	if ttl <= 0 {
+		ttl = defaultBacklogTTL
+	}
+
+	return &Hub{
+		backlog: backlog,
+		ttl:     ttl,
+		now:     time.Now,
+		users:   make(map[string]*userStream),
+	}
+}
+
+// Notify публикует напоминание. Повторная доставка того же напоминания (с тем же ключом
+// идемпотентности, см. notifiers.IdempotencyKey), еще хранящегося в backlog, игнорируется.
+func (h *Hub) Notify(_ context.Context, notification *app.EventNotification) error {
+	key := notifiers.IdempotencyKey(notification)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.prune()
+
+	stream := h.stream(notification.UserID)
+
+	for _, e := range stream.backlog {
+		if e.key == key {
+			return nil
+		}
+	}
+
+	h.seq++
+	e := &entry{id: h.seq, key: key, notification: notification, at: h.now()}
+
+	if len(stream.backlog) == h.backlog {
+		stream.evicted = stream.backlog[0].id
+		stream.backlog = stream.backlog[1:]
+	}
+	stream.backlog = append(stream.backlog, e)
+
+	for sub := range stream.subscribers {
+		select {
+		case sub <- e:
+		default:
+			delete(stream.subscribers, sub)
+			close(sub)
+		}
+	}
+
+	return nil
+}
+
+// subscribe подписывается на напоминания пользователя и возвращает напоминания из backlog
+// с номером больше lastID. complete равен false, если часть напоминаний после lastID уже вытеснена.
+// Канал подписки закрывается вызовом unsubscribe или при переполнении.
+func (h *Hub) subscribe(userID string, lastID uint64) (missed []*entry, complete bool, sub chan *entry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.prune()
+
+	stream := h.stream(userID)
+
+	for _, e := range stream.backlog {
+		if e.id > lastID {
+			missed = append(missed, e)
+		}
+	}
+
+	sub = make(chan *entry, subscriberBuffer)
+	stream.subscribers[sub] = struct{}{}
+
+	return missed, lastID >= stream.evicted, sub
+}
+
+// unsubscribe отписывает подписчика, если он еще не отключен.
+func (h *Hub) unsubscribe(userID string, sub chan *entry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	stream, ok := h.users[userID]
+	if !ok {
+		return
+	}
+
+	if _, ok = stream.subscribers[sub]; ok {
+		delete(stream.subscribers, sub)
+		close(sub)
+	}
+
+	h.release(userID, stream)
+}
+
+// stream возвращает поток пользователя, создавая его при необходимости. Вызывается под mu.
+// Напоминания пользователя с номерами до создания потока могли быть удалены вместе с прежним
+// потоком, поэтому они считаются вытесненными.
+func (h *Hub) stream(userID string) *userStream {
+	stream, ok := h.users[userID]
+	if !ok {
+		stream = &userStream{evicted: h.seq, subscribers: make(map[chan *entry]struct{})}
+		h.users[userID] = stream
+	}
+
+	return stream
+}
+
+// prune вытесняет из backlog напоминания старше ttl и удаляет потоки без подписчиков с пустым
+// backlog. Полный обход выполняется не чаще раза в ttl. Вызывается под mu.
+func (h *Hub) prune() {
+	now := h.now()
+	if now.Sub(h.prunedAt) < h.ttl {
+		return
+	}
+	h.prunedAt = now
+
+	deadline := now.Add(-h.ttl)
+
+	for userID, stream := range h.users {
+		expired := 0
+		for expired < len(stream.backlog) && stream.backlog[expired].at.Before(deadline) {
+			expired++
+		}
+
+		if expired > 0 {
+			stream.evicted = stream.backlog[expired-1].id
+			stream.backlog = stream.backlog[expired:]
+		}
+
+		h.release(userID, stream)
+	}
+}
+
+// release удаляет поток пользователя, если у него нет ни подписчиков, ни напоминаний в backlog.
+// Вызывается под mu.
+func (h *Hub) release(userID string, stream *userStream) {
+	if len(stream.subscribers) == 0 && len(stream.backlog) == 0 {
+		delete(h.users, userID)
+	}
+}
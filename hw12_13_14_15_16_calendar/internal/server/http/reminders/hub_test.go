@@ -0,0 +1,47 @@
+package reminders
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHubEviction(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	hub := NewHub(10, time.Hour)
+	hub.now = func() time.Time { return now }
+
+	t.Run("stream without backlog is removed on unsubscribe", func(t *testing.T) {
+		_, _, sub := hub.subscribe("visitor", 0)
+		require.Contains(t, hub.users, "visitor")
+
+		hub.unsubscribe("visitor", sub)
+		require.NotContains(t, hub.users, "visitor")
+	})
+
+	require.NoError(t, hub.Notify(ctx, notification("first")))
+	require.NoError(t, hub.Notify(ctx, notification("second")))
+	require.Contains(t, hub.users, "user")
+
+	t.Run("aged backlog is evicted", func(t *testing.T) {
+		now = now.Add(2 * time.Hour)
+
+		_, _, sub := hub.subscribe("other", 0)
+		hub.unsubscribe("other", sub)
+
+		require.NotContains(t, hub.users, "user")
+		require.Empty(t, hub.users)
+	})
+
+	t.Run("resume after eviction reports lost reminders", func(t *testing.T) {
+		missed, complete, sub := hub.subscribe("user", 1)
+		defer hub.unsubscribe("user", sub)
+
+		require.Empty(t, missed)
+		require.False(t, complete)
+	})
+}
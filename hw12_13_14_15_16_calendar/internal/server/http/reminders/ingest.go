@@ -0,0 +1,61 @@
+package reminders
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/app/sender/notifiers"
+	"github.com/rs/zerolog/log"
+)
+
+// Ограничения запроса на прием напоминания.
+const (
+	maxIngestBodyBytes = 64 << 10
+	// maxClockSkew допустимое расхождение X-Calendar-Timestamp с текущим временем
+	maxClockSkew = 5 * time.Minute
+)
+
+// NewIngestHandler создает http обработчик, принимающий напоминания от рассыльщика с каналом webhook.
+// Запрос должен быть подписан секретом secret (см. notifiers.Webhook), иначе отклоняется с кодом 401.
+func NewIngestHandler(hub *Hub, secret string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIngestBodyBytes))
+		if err != nil {
+			http.Error(w, "can't read body", http.StatusBadRequest)
+			return
+		}
+
+		timestamp := r.Header.Get(notifiers.HeaderTimestamp)
+
+		sentAt, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil || time.Since(time.Unix(sentAt, 0)).Abs() > maxClockSkew {
+			http.Error(w, "invalid timestamp", http.StatusUnauthorized)
+			return
+		}
+
+		signature := notifiers.Sign(secret, timestamp, body)
+		if !hmac.Equal([]byte(signature), []byte(r.Header.Get(notifiers.HeaderSignature))) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		var notification app.EventNotification
+		if err = json.Unmarshal(body, &notification); err != nil || notification.UserID == "" {
+			http.Error(w, "invalid reminder", http.StatusBadRequest)
+			return
+		}
+
+		if err = hub.Notify(r.Context(), &notification); err != nil {
+			log.Error().Err(err).Msg("[reminders::Ingest]: failed to publish reminder")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
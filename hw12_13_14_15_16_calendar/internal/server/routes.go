@@ -0,0 +1,26 @@
+// Package server содержит общие настройки grpc и HTTP серверов календаря.
+//
+// Аутентификация HTTP маршрутов. Маршруты grpc-gateway передают заголовок Authorization в grpc,
+// где его проверяет интерсептор аутентификации. Остальные маршруты не доверяют user_id из запроса:
+//   - ICalFeedRoute определяет пользователя по подписанному токену ленты (календарные клиенты
+//     не передают bearer токены), поэтому JWT не проверяет;
+//   - RemindersStreamRoute требует bearer JWT и берет пользователя из него;
+//   - RemindersIngestRoute принимает напоминания только с подписью общего секрета рассыльщика
+//     и пользователя не идентифицирует.
+package server
+
+import "fmt"
+
+// ICalFeedRoute шаблон пути iCalendar-ленты для chi роутера.
+const ICalFeedRoute = "/ical/{token}.ics"
+
+// Пути потока напоминаний: SSE поток для клиентов и прием напоминаний от рассыльщика.
+const (
+	RemindersStreamRoute = "/v1/reminders/stream"
+	RemindersIngestRoute = "/internal/reminders"
+)
+
+// ICalFeedPath возвращает путь iCalendar-ленты для токена.
+func ICalFeedPath(token string) string {
+	return fmt.Sprintf("/ical/%s.ics", token)
+}
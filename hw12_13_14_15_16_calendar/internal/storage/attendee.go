@@ -0,0 +1,44 @@
+package storage
+
+// AttendeeRole строковый алиас для роли участника события.
+type AttendeeRole = string
+
+// Роли участников события.
+const (
+	AttendeeRoleRequired AttendeeRole = "required"
+	AttendeeRoleOptional AttendeeRole = "optional"
+)
+
+// RSVPStatus строковый алиас для ответа участника на приглашение.
+type RSVPStatus = string
+
+// Ответы участников на приглашение. RSVPStatusNeedsAction - приглашение еще без ответа.
+const (
+	RSVPStatusNeedsAction RSVPStatus = "needs-action"
+	RSVPStatusAccepted    RSVPStatus = "accepted"
+	RSVPStatusDeclined    RSVPStatus = "declined"
+	RSVPStatusTentative   RSVPStatus = "tentative"
+)
+
+// IsValidRole сообщает, является ли role известной ролью участника.
+func IsValidRole(role AttendeeRole) bool {
+	return role == AttendeeRoleRequired || role == AttendeeRoleOptional
+}
+
+// IsValidResponse сообщает, может ли участник ответить на приглашение статусом status.
+func IsValidResponse(status RSVPStatus) bool {
+	return status == RSVPStatusAccepted || status == RSVPStatusDeclined || status == RSVPStatusTentative
+}
+
+// Attendee участник события, приглашенный владельцем или пользователем с доступом на запись.
+type Attendee struct {
+	EventID string       `db:"event_id"`
+	UserID  string       `db:"user_id"`
+	Role    AttendeeRole `db:"role"`
+	Status  RSVPStatus   `db:"status"`
+}
+
+// Reminded сообщает, получает ли участник напоминания о событии: их получают все, кто не отказался.
+func (a *Attendee) Reminded() bool {
+	return a.Status != RSVPStatusDeclined
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"slices"
+	"time"
+)
+
+// Calendar именованный календарь пользователя. У каждого пользователя есть ровно один календарь
+// по умолчанию (IsDefault), в который попадают события без явно указанного календаря.
+// DefaultNotifyInterval - напоминание для событий календаря, создаваемых без собственного напоминания.
+type Calendar struct {
+	ID                    string        `db:"id"`
+	UserID                string        `db:"user_id"`
+	Name                  string        `db:"name"`
+	Color                 string        `db:"color"`
+	DefaultNotifyInterval time.Duration `db:"default_notify_interval"`
+	IsDefault             bool          `db:"is_default"`
+	CreatedAt             time.Time     `db:"created_at"`
+}
+
+// InCalendars сообщает, относится ли событие к одному из календарей calendarIDs.
+// Пустой список календарей не ограничивает выборку.
+func (e *Event) InCalendars(calendarIDs []string) bool {
+	return len(calendarIDs) == 0 || slices.Contains(calendarIDs, e.CalendarID)
+}
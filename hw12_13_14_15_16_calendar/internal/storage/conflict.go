@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/pkg/errors" //nolint:depguard
+)
+
+// ConflictHorizon горизонт проверки пересечений для бесконечных повторяющихся событий.
+const ConflictHorizon = 365 * 24 * time.Hour
+
+// FindConflict ищет среди others занятое событие того же пользователя, пересекающееся с event.
+// Повторяющиеся события сравниваются по вхождениям. Если event не занимает время, конфликтов нет.
+func FindConflict(event *Event, others []*Event) (*Event, error) {
+	if !event.Busy {
+		return nil, nil
+	}
+
+	from := *event.StartsAt
+
+	to, err := event.RecurrenceEnd()
+	if err != nil {
+		return nil, errors.Wrap(err, "[storage::FindConflict]")
+	}
+
+	if to == nil {
+		horizon := from.Add(ConflictHorizon)
+		to = &horizon
+	}
+
+	occurrences, err := event.Occurrences(from, *to)
+	if err != nil {
+		return nil, errors.Wrap(err, "[storage::FindConflict]")
+	}
+
+	for _, other := range others {
+		if !other.Busy || other.ID == event.ID || other.UserID != event.UserID {
+			continue
+		}
+
+		otherOccurrences, err := other.Occurrences(from, *to)
+		if err != nil {
+			return nil, errors.Wrap(err, "[storage::FindConflict]")
+		}
+
+		if overlaps(occurrences, otherOccurrences) {
+			return other, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// overlaps проверяет, пересекаются ли два отсортированных по началу списка интервалов.
+func overlaps(a, b []*Event) bool {
+	i, j := 0, 0
+
+	for i < len(a) && j < len(b) {
+		if a[i].StartsAt.Before(*b[j].EndsAt) && b[j].StartsAt.Before(*a[i].EndsAt) {
+			return true
+		}
+
+		if a[i].EndsAt.Before(*b[j].EndsAt) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return false
+}
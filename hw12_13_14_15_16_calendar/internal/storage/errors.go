@@ -0,0 +1,14 @@
+package storage
+
+import "github.com/pkg/errors" //nolint:depguard
+
+// Ошибки хранилища событий.
+var (
+	ErrEventExists      = errors.New("event already exists")
+	ErrEventNotFound    = errors.New("event not found")
+	ErrDateBusy         = errors.New("date is busy by another event")
+	ErrGrantNotFound    = errors.New("grant not found")
+	ErrAttendeeNotFound = errors.New("attendee not found")
+	ErrCalendarNotFound = errors.New("calendar not found")
+	ErrCalendarExists   = errors.New("default calendar already exists")
+)
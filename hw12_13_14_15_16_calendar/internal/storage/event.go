@@ -2,14 +2,23 @@ package storage
 
 import "time"
 
-// Event структура для хранения данных о событии.
+// Event структура для хранения данных о событии. Участники Attendees хранятся отдельно от события
+// и не сериализуются вместе с ним.
 type Event struct {
-	ID             string        `db:"id" json:"id,omitempty"`
-	Title          string        `db:"title" json:"title,omitempty"`
-	StartsAt       *time.Time    `db:"starts_at" json:"starts_at,omitempty"`
-	EndsAt         *time.Time    `db:"ends_at" json:"ends_at,omitempty"`
-	Description    string        `db:"description" json:"description,omitempty"`
-	UserID         string        `db:"user_id" json:"user_id,omitempty"`
-	NotifyInterval time.Duration `db:"notify_interval" json:"notify_interval,omitempty"`
-	Processed      *bool         `db:"processed" json:"processed,omitempty"`
+	ID                   string        `db:"id" json:"id,omitempty"`
+	Title                string        `db:"title" json:"title,omitempty"`
+	StartsAt             *time.Time    `db:"starts_at" json:"starts_at,omitempty"`
+	EndsAt               *time.Time    `db:"ends_at" json:"ends_at,omitempty"`
+	Description          string        `db:"description" json:"description,omitempty"`
+	UserID               string        `db:"user_id" json:"user_id,omitempty"`
+	CalendarID           string        `db:"calendar_id" json:"calendar_id,omitempty"`
+	NotifyInterval       time.Duration `db:"notify_interval" json:"notify_interval,omitempty"`
+	Processed            *bool         `db:"processed" json:"processed,omitempty"`
+	RRule                string        `db:"rrule" json:"rrule,omitempty"`
+	ExDates              []time.Time   `db:"exdates" json:"exdates,omitempty"`
+	NotifiedOccurrenceAt *time.Time    `db:"notified_occurrence_at" json:"notified_occurrence_at,omitempty"`
+	ICalUID              string        `db:"ical_uid" json:"ical_uid,omitempty"`
+	Tentative            bool          `db:"tentative" json:"tentative,omitempty"`
+	Busy                 bool          `db:"busy" json:"busy,omitempty"`
+	Attendees            []*Attendee   `db:"-" json:"-"`
 }
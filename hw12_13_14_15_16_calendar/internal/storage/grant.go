@@ -0,0 +1,42 @@
+package storage
+
+import "time"
+
+// Permission строковый алиас для уровня доступа к календарю другого пользователя.
+type Permission = string
+
+// Уровни доступа к календарю. Каждый уровень включает предыдущие.
+const (
+	// PermissionFreeBusy разрешает видеть только интервалы занятости.
+	PermissionFreeBusy Permission = "free-busy"
+	// PermissionRead разрешает читать события.
+	PermissionRead Permission = "read"
+	// PermissionWrite разрешает создавать, изменять и удалять события.
+	PermissionWrite Permission = "write"
+)
+
+var permissionLevels = map[Permission]int{
+	PermissionFreeBusy: 1,
+	PermissionRead:     2,
+	PermissionWrite:    3,
+}
+
+// IsValidPermission сообщает, является ли permission известным уровнем доступа.
+func IsValidPermission(permission Permission) bool {
+	_, ok := permissionLevels[permission]
+
+	return ok
+}
+
+// Grant разрешение владельца календаря OwnerID пользователю GranteeID.
+type Grant struct {
+	OwnerID    string     `db:"owner_id"`
+	GranteeID  string     `db:"grantee_id"`
+	Permission Permission `db:"permission"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+// Allows сообщает, включает ли разрешение уровень доступа required.
+func (g *Grant) Allows(required Permission) bool {
+	return permissionLevels[g.Permission] >= permissionLevels[required]
+}
@@ -12,7 +12,27 @@ type IRepository interface {
 	CreateEvent(ctx context.Context, event *Event) error
 	UpdateEvent(ctx context.Context, event *Event) error
 	DeleteEvent(ctx context.Context, eventID string) error
-	ReadDailyEvents(ctx context.Context, userID string, date time.Time) ([]*Event, error)
-	ReadWeeklyEvents(ctx context.Context, userID string, fromDate time.Time) ([]*Event, error)
-	ReadMonthlyEvents(ctx context.Context, userID string, fromDate time.Time) ([]*Event, error)
+	ReadEvent(ctx context.Context, eventID string) (*Event, error)
+	ReadDailyEvents(ctx context.Context, userID string, date time.Time, calendarIDs []string) ([]*Event, error)
+	ReadWeeklyEvents(ctx context.Context, userID string, fromDate time.Time, calendarIDs []string) ([]*Event, error)
+	ReadMonthlyEvents(ctx context.Context, userID string, fromDate time.Time, calendarIDs []string) ([]*Event, error)
+	ReadEventsInRange(ctx context.Context, userIDs []string, from, to time.Time) ([]*Event, error)
+	ListEvents(ctx context.Context, query *ListEventsQuery) ([]*Event, error)
+	SearchEvents(ctx context.Context, userID, query string, calendarIDs []string, limit int) ([]*Event, error)
+	SetUserTimeZone(ctx context.Context, userID, timeZone string) error
+	ReadUserTimeZone(ctx context.Context, userID string) (string, error)
+	SaveGrant(ctx context.Context, grant *Grant) error
+	DeleteGrant(ctx context.Context, ownerID, granteeID string) error
+	ReadGrant(ctx context.Context, ownerID, granteeID string) (*Grant, error)
+	ListGrants(ctx context.Context, ownerID string) ([]*Grant, error)
+	SaveAttendee(ctx context.Context, attendee *Attendee) error
+	SetAttendeeStatus(ctx context.Context, eventID, userID string, status RSVPStatus) error
+	ListInvitations(ctx context.Context, userID string) ([]*Event, error)
+	CreateCalendar(ctx context.Context, calendar *Calendar) error
+	UpdateCalendar(ctx context.Context, calendar *Calendar) error
+	DeleteCalendar(ctx context.Context, calendarID string) ([]*Event, error)
+	ReadCalendar(ctx context.Context, calendarID string) (*Calendar, error)
+	ReadDefaultCalendar(ctx context.Context, userID string) (*Calendar, error)
+	ListCalendars(ctx context.Context, userID string) ([]*Calendar, error)
+	DeleteEventsEndedBefore(ctx context.Context, before time.Time) (int64, error)
 }
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"strings"
+	"time"
+)
+
+// SortOrder строковый алиас для порядка сортировки событий по началу.
+type SortOrder = string
+
+// Порядки сортировки событий.
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// EventCursor позиция события в порядке (StartsAt, ID), после которой продолжается выборка.
+type EventCursor struct {
+	StartsAt time.Time
+	ID       string
+}
+
+// ListEventsQuery параметры выборки событий пользователя. Нулевые From и To не ограничивают интервал.
+// Повторяющиеся события не разворачиваются во вхождения и попадают в выборку целиком,
+// если хотя бы одно вхождение может пересечься с [From, To).
+// Query - подстрока названия или описания без учета регистра.
+// CalendarIDs - календари, события которых попадают в выборку; пустой список не ограничивает выборку.
+// After - курсор последнего события предыдущей страницы, Limit - максимальный размер страницы.
+type ListEventsQuery struct {
+	UserID      string
+	CalendarIDs []string
+	From        time.Time
+	To          time.Time
+	Query       string
+	Order       SortOrder
+	After       *EventCursor
+	Limit       int
+}
+
+// Cursor возвращает курсор события.
+func (e *Event) Cursor() EventCursor {
+	return EventCursor{StartsAt: *e.StartsAt, ID: e.ID}
+}
+
+// Compare сравнивает курсоры в порядке (StartsAt, ID).
+func (c EventCursor) Compare(other EventCursor) int {
+	if cmp := c.StartsAt.Compare(other.StartsAt); cmp != 0 {
+		return cmp
+	}
+
+	return strings.Compare(c.ID, other.ID)
+}
+
+// MatchesText сообщает, содержат ли название или описание события подстроку query без учета регистра.
+func (e *Event) MatchesText(query string) bool {
+	if query == "" {
+		return true
+	}
+
+	query = strings.ToLower(query)
+
+	return strings.Contains(strings.ToLower(e.Title), query) || strings.Contains(strings.ToLower(e.Description), query)
+}
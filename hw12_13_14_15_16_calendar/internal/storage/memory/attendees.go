@@ -0,0 +1,106 @@
+package memorystorage
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// SaveAttendee сохраняет участника события. У уже приглашенного участника обновляется только роль.
+func (r *Repository) SaveAttendee(_ context.Context, attendee *storage.Attendee) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.eventsByID[attendee.EventID]; !exists {
+		return errors.Wrapf(storage.ErrEventNotFound,
+			"[memorystorage::SaveAttendee]: event with ID %s", attendee.EventID)
+	}
+
+	attendees := r.attendees[attendee.EventID]
+
+	i, found := slices.BinarySearchFunc(attendees, attendee.UserID, compareAttendee)
+	if found {
+		attendees[i].Role = attendee.Role
+		return nil
+	}
+
+	copied := *attendee
+	r.attendees[attendee.EventID] = slices.Insert(attendees, i, &copied)
+
+	return nil
+}
+
+// SetAttendeeStatus сохраняет ответ участника userID на приглашение на событие eventID.
+func (r *Repository) SetAttendeeStatus(_ context.Context, eventID, userID string, status storage.RSVPStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attendees := r.attendees[eventID]
+
+	i, found := slices.BinarySearchFunc(attendees, userID, compareAttendee)
+	if !found {
+		return errors.Wrapf(storage.ErrAttendeeNotFound,
+			"[memorystorage::SetAttendeeStatus]: user %s in event %s", userID, eventID)
+	}
+
+	attendees[i].Status = status
+
+	return nil
+}
+
+// ListInvitations читает события, на которые приглашен пользователь, упорядоченные по началу.
+func (r *Repository) ListInvitations(_ context.Context, userID string) ([]*storage.Event, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*storage.Event
+
+	for _, event := range r.sortedEvents {
+		if _, found := slices.BinarySearchFunc(r.attendees[event.ID], userID, compareAttendee); !found {
+			continue
+		}
+
+		result = append(result, r.copyEvent(event))
+	}
+
+	return result, nil
+}
+
+// copyEvent возвращает копию события вместе с копиями его участников. Вызывается под мьютексом.
+func (r *Repository) copyEvent(event *storage.Event) *storage.Event {
+	copied := *event
+	copied.Attendees = nil
+
+	for _, attendee := range r.attendees[event.ID] {
+		copiedAttendee := *attendee
+		copied.Attendees = append(copied.Attendees, &copiedAttendee)
+	}
+
+	return &copied
+}
+
+// visibleTo возвращает фильтр событий календарей calendarIDs, которые пользователь видит в своем
+// календаре: собственных и тех, приглашение на которые он принял. Фильтр вызывается под мьютексом.
+func (r *Repository) visibleTo(userID string, calendarIDs []string) func(event *storage.Event) bool {
+	return func(event *storage.Event) bool {
+		if !event.InCalendars(calendarIDs) {
+			return false
+		}
+
+		if event.UserID == userID {
+			return true
+		}
+
+		attendees := r.attendees[event.ID]
+		i, found := slices.BinarySearchFunc(attendees, userID, compareAttendee)
+
+		return found && attendees[i].Status == storage.RSVPStatusAccepted
+	}
+}
+
+func compareAttendee(attendee *storage.Attendee, userID string) int {
+	return strings.Compare(attendee.UserID, userID)
+}
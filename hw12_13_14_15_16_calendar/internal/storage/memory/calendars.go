@@ -0,0 +1,149 @@
+package memorystorage
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// CreateCalendar сохраняет календарь и проставляет ему ID. У пользователя может быть только один
+// календарь по умолчанию.
+func (r *Repository) CreateCalendar(_ context.Context, calendar *storage.Calendar) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if calendar.IsDefault {
+		if _, ok := r.defaultCalendar(calendar.UserID); ok {
+			return errors.Wrapf(storage.ErrCalendarExists, "[memorystorage::CreateCalendar]: user %s", calendar.UserID)
+		}
+	}
+
+	calendar.ID = uuid.New().String()
+
+	copied := *calendar
+	r.calendars[calendar.ID] = &copied
+
+	return nil
+}
+
+// UpdateCalendar обновляет название, цвет и напоминание по умолчанию календаря.
+func (r *Repository) UpdateCalendar(_ context.Context, calendar *storage.Calendar) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.calendars[calendar.ID]
+	if !ok {
+		return errors.Wrapf(storage.ErrCalendarNotFound, "[memorystorage::UpdateCalendar]: calendar with ID %s", calendar.ID)
+	}
+
+	stored.Name = calendar.Name
+	stored.Color = calendar.Color
+	stored.DefaultNotifyInterval = calendar.DefaultNotifyInterval
+
+	return nil
+}
+
+// DeleteCalendar удаляет календарь вместе с его событиями и возвращает удаленные события с участниками.
+func (r *Repository) DeleteCalendar(_ context.Context, calendarID string) ([]*storage.Event, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.calendars[calendarID]; !ok {
+		return nil, errors.Wrapf(storage.ErrCalendarNotFound,
+			"[memorystorage::DeleteCalendar]: calendar with ID %s", calendarID)
+	}
+
+	delete(r.calendars, calendarID)
+
+	var deleted []*storage.Event
+
+	ids := make(map[string]struct{})
+	for _, event := range r.sortedEvents {
+		if event.CalendarID == calendarID {
+			ids[event.ID] = struct{}{}
+			deleted = append(deleted, r.copyEvent(event))
+		}
+	}
+
+	r.removeEvents(ids)
+
+	return deleted, nil
+}
+
+// ReadCalendar читает календарь по ID.
+func (r *Repository) ReadCalendar(_ context.Context, calendarID string) (*storage.Calendar, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	calendar, ok := r.calendars[calendarID]
+	if !ok {
+		return nil, errors.Wrapf(storage.ErrCalendarNotFound,
+			"[memorystorage::ReadCalendar]: calendar with ID %s", calendarID)
+	}
+
+	copied := *calendar
+
+	return &copied, nil
+}
+
+// ReadDefaultCalendar читает календарь пользователя по умолчанию.
+func (r *Repository) ReadDefaultCalendar(_ context.Context, userID string) (*storage.Calendar, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	calendar, ok := r.defaultCalendar(userID)
+	if !ok {
+		return nil, errors.Wrapf(storage.ErrCalendarNotFound, "[memorystorage::ReadDefaultCalendar]: user %s", userID)
+	}
+
+	copied := *calendar
+
+	return &copied, nil
+}
+
+// ListCalendars читает календари пользователя: сначала календарь по умолчанию, затем по названию.
+func (r *Repository) ListCalendars(_ context.Context, userID string) ([]*storage.Calendar, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*storage.Calendar
+
+	for _, calendar := range r.calendars {
+		if calendar.UserID == userID {
+			copied := *calendar
+			result = append(result, &copied)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b *storage.Calendar) int {
+		if a.IsDefault != b.IsDefault {
+			if a.IsDefault {
+				return -1
+			}
+			return 1
+		}
+
+		if cmp := strings.Compare(a.Name, b.Name); cmp != 0 {
+			return cmp
+		}
+
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return result, nil
+}
+
+// defaultCalendar ищет календарь пользователя по умолчанию. Вызывается под мьютексом.
+func (r *Repository) defaultCalendar(userID string) (*storage.Calendar, bool) {
+	for _, calendar := range r.calendars {
+		if calendar.UserID == userID && calendar.IsDefault {
+			return calendar, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,74 @@
+package memorystorage
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// SaveGrant сохраняет разрешение, заменяя прежнее разрешение того же пользователя.
+func (r *Repository) SaveGrant(_ context.Context, grant *storage.Grant) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	grants, ok := r.grants[grant.OwnerID]
+	if !ok {
+		grants = make(map[string]*storage.Grant)
+		r.grants[grant.OwnerID] = grants
+	}
+
+	copied := *grant
+	grants[grant.GranteeID] = &copied
+
+	return nil
+}
+
+// DeleteGrant удаляет разрешение пользователю granteeID на календарь ownerID.
+func (r *Repository) DeleteGrant(_ context.Context, ownerID, granteeID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.grants[ownerID][granteeID]; !ok {
+		return errors.Wrapf(storage.ErrGrantNotFound, "[memorystorage::DeleteGrant]: %s -> %s", ownerID, granteeID)
+	}
+
+	delete(r.grants[ownerID], granteeID)
+
+	return nil
+}
+
+// ReadGrant читает разрешение пользователю granteeID на календарь ownerID.
+func (r *Repository) ReadGrant(_ context.Context, ownerID, granteeID string) (*storage.Grant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	grant, ok := r.grants[ownerID][granteeID]
+	if !ok {
+		return nil, errors.Wrapf(storage.ErrGrantNotFound, "[memorystorage::ReadGrant]: %s -> %s", ownerID, granteeID)
+	}
+
+	copied := *grant
+
+	return &copied, nil
+}
+
+// ListGrants читает разрешения на календарь ownerID, упорядоченные по пользователю.
+func (r *Repository) ListGrants(_ context.Context, ownerID string) ([]*storage.Grant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*storage.Grant, 0, len(r.grants[ownerID]))
+	for _, grant := range r.grants[ownerID] {
+		copied := *grant
+		result = append(result, &copied)
+	}
+
+	slices.SortFunc(result, func(a, b *storage.Grant) int {
+		return strings.Compare(a.GranteeID, b.GranteeID)
+	})
+
+	return result, nil
+}
@@ -0,0 +1,98 @@
+package memorystorage
+
+import (
+	"context"
+	"slices"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+)
+
+// titleWeight вес вхождения слова в название события относительно вхождения в описание.
+const titleWeight = 2
+
+// termWeights возвращает веса слов события: вхождение в название весит titleWeight, в описание - 1.
+func termWeights(event *storage.Event) map[string]int {
+	weights := make(map[string]int)
+
+	for _, term := range storage.Tokenize(event.Title) {
+		weights[term] += titleWeight
+	}
+
+	for _, term := range storage.Tokenize(event.Description) {
+		weights[term]++
+	}
+
+	return weights
+}
+
+// index добавляет слова события в инвертированный индекс. Вызывается под мьютексом на запись.
+func (r *Repository) index(event *storage.Event) {
+	for term, weight := range termWeights(event) {
+		postings, ok := r.terms[term]
+		if !ok {
+			postings = make(map[string]int)
+			r.terms[term] = postings
+		}
+
+		postings[event.ID] = weight
+	}
+}
+
+// unindex удаляет слова события из инвертированного индекса. Вызывается под мьютексом на запись.
+func (r *Repository) unindex(event *storage.Event) {
+	for term := range termWeights(event) {
+		delete(r.terms[term], event.ID)
+
+		if len(r.terms[term]) == 0 {
+			delete(r.terms, term)
+		}
+	}
+}
+
+// SearchEvents ищет до limit событий пользователя в календарях calendarIDs, содержащих хотя бы одно
+// из слов query (см. storage.SearchTerms) в названии или описании. События упорядочены по убыванию
+// суммарного веса найденных слов, затем по началу.
+func (r *Repository) SearchEvents(
+	_ context.Context,
+	userID, query string,
+	calendarIDs []string,
+	limit int,
+) ([]*storage.Event, error) {
+	terms := storage.SearchTerms(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ranks := make(map[string]int)
+
+	for _, term := range terms {
+		for id, weight := range r.terms[term] {
+			if event := r.eventsByID[id]; event.UserID == userID && event.InCalendars(calendarIDs) {
+				ranks[id] += weight
+			}
+		}
+	}
+
+	result := make([]*storage.Event, 0, len(ranks))
+	for id := range ranks {
+		copied := *r.eventsByID[id]
+		result = append(result, &copied)
+	}
+
+	slices.SortFunc(result, func(a, b *storage.Event) int {
+		if cmp := ranks[b.ID] - ranks[a.ID]; cmp != 0 {
+			return cmp
+		}
+
+		return a.Cursor().Compare(b.Cursor())
+	})
+
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
@@ -16,7 +16,34 @@ type Repository struct {
 	eventsByID   map[string]*storage.Event
 	eventsByUser map[string][]*storage.Event
 	sortedEvents []*storage.Event
-	mu           sync.RWMutex
+	// terms инвертированный индекс: слово -> ID события -> вес слова в событии
+	terms     map[string]map[string]int
+	timeZones map[string]string
+	// grants разрешения: владелец календаря -> пользователь -> разрешение
+	grants map[string]map[string]*storage.Grant
+	// attendees участники событий по ID события, упорядоченные по пользователю
+	attendees map[string][]*storage.Attendee
+	calendars map[string]*storage.Calendar
+	outbox    []*storage.Notification
+	// queued ключи уведомлений outbox, повторяющие уникальный индекс (event_id, starts_at, user_id) в postgres
+	queued    map[notificationKey]struct{}
+	outboxSeq int64
+	mu        sync.RWMutex
+}
+
+// notificationKey ключ уведомления одного получателя об одном вхождении события.
+type notificationKey struct {
+	eventID  string
+	startsAt time.Time
+	userID   string
+}
+
+func keyOf(notification *storage.Notification) notificationKey {
+	return notificationKey{
+		eventID:  notification.EventID,
+		startsAt: notification.StartsAt.UTC(),
+		userID:   notification.UserID,
+	}
 }
 
 // New конструктор БД типа in-memory.
@@ -24,6 +51,12 @@ func New() *Repository {
 	return &Repository{
 		eventsByID:   make(map[string]*storage.Event),
 		eventsByUser: make(map[string][]*storage.Event),
+		terms:        make(map[string]map[string]int),
+		timeZones:    make(map[string]string),
+		grants:       make(map[string]map[string]*storage.Grant),
+		attendees:    make(map[string][]*storage.Attendee),
+		calendars:    make(map[string]*storage.Calendar),
+		queued:       make(map[notificationKey]struct{}),
 	}
 }
 
@@ -39,31 +72,62 @@ func (r *Repository) CreateEvent(_ context.Context, event *storage.Event) error
 	defer r.mu.Unlock()
 
 	if _, exists := r.eventsByID[event.ID]; exists {
-		return errors.Errorf("[memorystorage::CreateEvent]: event with ID %s already exists", event.ID)
+		return errors.Wrapf(storage.ErrEventExists, "[memorystorage::CreateEvent]: event with ID %s", event.ID)
+	}
+
+	if event.ICalUID != "" {
+		for _, e := range r.eventsByUser[event.UserID] {
+			if e.ICalUID == event.ICalUID {
+				return errors.Wrapf(storage.ErrEventExists, "[memorystorage::CreateEvent]: event with UID %s", event.ICalUID)
+			}
+		}
+	}
+
+	if err := r.checkConflict(event); err != nil {
+		return errors.Wrap(err, "[memorystorage::CreateEvent]")
 	}
 
 	event.ID = uuid.New().String() // имитируем поведение "UUID PRIMARY KEY" как в postgres
 	r.eventsByID[event.ID] = event
 	r.eventsByUser[event.UserID] = append(r.eventsByUser[event.UserID], event)
+	r.index(event)
 
 	r.sortedEvents = append(r.sortedEvents, event)
-	slices.SortFunc(r.sortedEvents, func(i, j *storage.Event) int {
-		return i.StartsAt.Compare(*j.StartsAt)
-	})
+	r.sortEvents()
 
 	return nil
 }
 
-// UpdateEvent обновляет событие в БД.
+// UpdateEvent обновляет событие в БД. Незаполненные служебные поля сохраняют прежние значения,
+// как пропущенные storage.Serialize колонки в postgres.
 func (r *Repository) UpdateEvent(_ context.Context, event *storage.Event) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.eventsByID[event.ID]; !exists {
-		return errors.Errorf("[memorystorage::UpdateEvent]: event with ID %s does not exist", event.ID)
+	previous, exists := r.eventsByID[event.ID]
+	if !exists {
+		return errors.Wrapf(storage.ErrEventNotFound, "[memorystorage::UpdateEvent]: event with ID %s", event.ID)
+	}
+
+	if event.ICalUID == "" {
+		event.ICalUID = previous.ICalUID
+	}
+
+	if event.Processed == nil {
+		event.Processed = previous.Processed
+	}
+
+	if event.NotifiedOccurrenceAt == nil {
+		event.NotifiedOccurrenceAt = previous.NotifiedOccurrenceAt
+	}
+
+	if err := r.checkConflict(event); err != nil {
+		return errors.Wrap(err, "[memorystorage::UpdateEvent]")
 	}
 
+	r.unindex(previous)
 	r.eventsByID[event.ID] = event
+	r.index(event)
 
 	for i, e := range r.eventsByUser[event.UserID] {
 		if e.ID == event.ID {
@@ -79,9 +143,30 @@ func (r *Repository) UpdateEvent(_ context.Context, event *storage.Event) error
 		}
 	}
 
+	r.sortEvents()
+
+	return nil
+}
+
+// sortEvents упорядочивает sortedEvents по (StartsAt, ID), чтобы курсор ListEvents однозначно
+// определял позицию события. Вызывается под мьютексом на запись.
+func (r *Repository) sortEvents() {
 	slices.SortFunc(r.sortedEvents, func(i, j *storage.Event) int {
-		return i.StartsAt.Compare(*j.StartsAt)
+		return i.Cursor().Compare(j.Cursor())
 	})
+}
+
+// checkConflict проверяет, что занятое событие не пересекается с другими занятыми событиями пользователя.
+// Вызывается под мьютексом на запись, поэтому проверка и сохранение атомарны.
+func (r *Repository) checkConflict(event *storage.Event) error {
+	conflict, err := storage.FindConflict(event, r.eventsByUser[event.UserID])
+	if err != nil {
+		return err
+	}
+
+	if conflict != nil {
+		return errors.Wrapf(storage.ErrDateBusy, "overlaps with event %s", conflict.ID)
+	}
 
 	return nil
 }
@@ -93,10 +178,12 @@ func (r *Repository) DeleteEvent(_ context.Context, eventID string) error {
 
 	event, exists := r.eventsByID[eventID]
 	if !exists {
-		return errors.Errorf("[memorystorage::UpdateEvent]: event with ID %s does not exist", eventID)
+		return errors.Wrapf(storage.ErrEventNotFound, "[memorystorage::DeleteEvent]: event with ID %s", eventID)
 	}
 
 	delete(r.eventsByID, eventID)
+	delete(r.attendees, eventID)
+	r.unindex(event)
 
 	userEvents := r.eventsByUser[event.UserID]
 	for i, e := range userEvents {
@@ -113,78 +200,347 @@ func (r *Repository) DeleteEvent(_ context.Context, eventID string) error {
 		}
 	}
 
+	r.dropNotifications(func(id string) bool {
+		return id == eventID
+	})
+
 	return nil
 }
 
-// ReadDailyEvents читает события за указанную дату.
-func (r *Repository) ReadDailyEvents(_ context.Context, userID string, date time.Time) ([]*storage.Event, error) {
+// ReadEvent читает событие по ID.
+func (r *Repository) ReadEvent(_ context.Context, eventID string) (*storage.Event, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
+	event, exists := r.eventsByID[eventID]
+	if !exists {
+		return nil, errors.Wrapf(storage.ErrEventNotFound, "[memorystorage::ReadEvent]: event with ID %s", eventID)
+	}
+
+	return r.copyEvent(event), nil
+}
+
+// ReadDailyEvents читает события за указанную дату.
+func (r *Repository) ReadDailyEvents(
+	_ context.Context,
+	userID string,
+	date time.Time,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
 	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-	end := start.Add(24 * time.Hour)
+	end := start.AddDate(0, 0, 1)
 
-	var result []*storage.Event
-	for _, event := range r.sortedEvents {
-		if event.UserID == userID && event.StartsAt.Before(end) && event.EndsAt.After(start) {
-			result = append(result, event)
-		}
-	}
+	events, err := r.readEvents(start, end, r.visibleTo(userID, calendarIDs))
 
-	return result, nil
+	return events, errors.Wrap(err, "[memorystorage::ReadDailyEvents]")
 }
 
 // ReadWeeklyEvents читает события за неделю, начиная с указанной даты.
-func (r *Repository) ReadWeeklyEvents(_ context.Context, userID string, fromDate time.Time) ([]*storage.Event, error) {
+func (r *Repository) ReadWeeklyEvents(
+	_ context.Context,
+	userID string,
+	fromDate time.Time,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
+	start := time.Date(fromDate.Year(), fromDate.Month(), fromDate.Day(), 0, 0, 0, 0, fromDate.Location())
+	end := start.AddDate(0, 0, 7)
+
+	events, err := r.readEvents(start, end, r.visibleTo(userID, calendarIDs))
+
+	return events, errors.Wrap(err, "[memorystorage::ReadWeeklyEvents]")
+}
+
+// ReadMonthlyEvents читает события за месяц, начиная с указанной даты.
+func (r *Repository) ReadMonthlyEvents(
+	_ context.Context,
+	userID string,
+	fromDate time.Time,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
+	start := time.Date(fromDate.Year(), fromDate.Month(), fromDate.Day(), 0, 0, 0, 0, fromDate.Location())
+	end := time.Date(fromDate.Year(), fromDate.Month()+1, fromDate.Day()+1, 0, 0, 0, 0, fromDate.Location())
+
+	events, err := r.readEvents(start, end, r.visibleTo(userID, calendarIDs))
+
+	return events, errors.Wrap(err, "[memorystorage::ReadMonthlyEvents]")
+}
+
+// ReadEventsInRange читает события указанных пользователей, пересекающиеся с интервалом [from, to):
+// собственные и те, приглашение на которые пользователь принял.
+func (r *Repository) ReadEventsInRange(
+	_ context.Context,
+	userIDs []string,
+	from, to time.Time,
+) ([]*storage.Event, error) {
+	events, err := r.readEvents(from, to, func(event *storage.Event) bool {
+		return slices.ContainsFunc(userIDs, func(userID string) bool {
+			return r.visibleTo(userID, nil)(event)
+		})
+	})
+
+	return events, errors.Wrap(err, "[memorystorage::ReadEventsInRange]")
+}
+
+// ListEvents читает страницу событий пользователя по параметрам query. Границы выборки в sortedEvents
+// находятся бинарным поиском по началу события и курсору.
+func (r *Repository) ListEvents(_ context.Context, query *storage.ListEventsQuery) ([]*storage.Event, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	start := time.Date(fromDate.Year(), fromDate.Month(), fromDate.Day(), 0, 0, 0, 0, fromDate.Location())
-	end := start.Add(7 * 24 * time.Hour)
+	// события в [lo, hi) начинаются до query.To и лежат после курсора в порядке выборки
+	lo, hi := 0, len(r.sortedEvents)
+
+	if !query.To.IsZero() {
+		hi, _ = slices.BinarySearchFunc(r.sortedEvents, query.To, func(e *storage.Event, to time.Time) int {
+			return e.StartsAt.Compare(to)
+		})
+	}
+
+	if query.After != nil {
+		compare := func(e *storage.Event, c storage.EventCursor) int {
+			return e.Cursor().Compare(c)
+		}
+
+		pos, found := slices.BinarySearchFunc(r.sortedEvents, *query.After, compare)
+
+		if query.Order == storage.SortOrderDesc {
+			hi = min(hi, pos)
+		} else {
+			if found {
+				pos++
+			}
+			lo = max(lo, pos)
+		}
+	}
 
 	var result []*storage.Event
-	for _, event := range r.sortedEvents {
-		if event.UserID == userID && event.StartsAt.Before(end) && event.EndsAt.After(start) {
-			result = append(result, event)
+
+	for i := range max(hi-lo, 0) {
+		event := r.sortedEvents[lo+i]
+		if query.Order == storage.SortOrderDesc {
+			event = r.sortedEvents[hi-1-i]
+		}
+
+		if event.UserID != query.UserID || !event.InCalendars(query.CalendarIDs) || !event.MatchesText(query.Query) {
+			continue
+		}
+
+		if !query.From.IsZero() {
+			end, err := event.RecurrenceEnd()
+			if err != nil {
+				return nil, errors.Wrap(err, "[memorystorage::ListEvents]")
+			}
+
+			if end != nil && !end.After(query.From) {
+				continue
+			}
+		}
+
+		copied := *event
+		result = append(result, &copied)
+
+		if len(result) == query.Limit {
+			break
 		}
 	}
 
 	return result, nil
 }
 
-// ReadMonthlyEvents читает события за месяц, начиная с указанной даты.
-func (r *Repository) ReadMonthlyEvents(_ context.Context, userID string, fromDate time.Time) ([]*storage.Event, error) {
+// readEvents читает события, отобранные фильтром include и пересекающиеся с интервалом [start, end),
+// разворачивая повторяющиеся события во вхождения.
+func (r *Repository) readEvents(
+	start, end time.Time,
+	include func(event *storage.Event) bool,
+) ([]*storage.Event, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
-	start := time.Date(fromDate.Year(), fromDate.Month(), fromDate.Day(), 0, 0, 0, 0, fromDate.Location())
-	end := time.Date(fromDate.Year(), fromDate.Month()+1, fromDate.Day()+1, 0, 0, 0, 0, fromDate.Location())
-
-	var result []*storage.Event
+	var candidates []*storage.Event
 	for _, event := range r.sortedEvents {
-		if event.UserID == userID && event.StartsAt.Before(end) && event.EndsAt.After(start) {
-			result = append(result, event)
+		if !event.StartsAt.Before(end) {
+			break
+		}
+
+		if (event.IsRecurring() || event.EndsAt.After(start)) && include(event) {
+			candidates = append(candidates, r.copyEvent(event))
 		}
 	}
 
-	return result, nil
+	return storage.ExpandEvents(candidates, start, end)
 }
 
-// ReadEventsToNotify читает события, у которых (starts_at - now()) <= notify_interval.
-func (r *Repository) ReadEventsToNotify(_ context.Context) ([]*storage.Event, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+// EnqueueNotifications атомарно помещает в outbox уведомления о событиях, у которых
+// (starts_at - now()) <= notify_interval, и отмечает события как обработанные.
+// Уведомления получают владелец события и участники, не отказавшиеся от приглашения.
+// Для повторяющихся событий в outbox попадают вхождения, о которых еще не было уведомления.
+// Повторное уведомление того же получателя о том же вхождении не добавляется.
+func (r *Repository) EnqueueNotifications(_ context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
 	now := time.Now().UTC()
-	processed := true
 
-	var result []*storage.Event
+	var (
+		due           []*storage.Event
+		notifiedUntil = make(map[*storage.Event]*time.Time)
+	)
+
 	for _, event := range r.sortedEvents {
-		if event.StartsAt.Sub(now) <= event.NotifyInterval && event.EndsAt.Sub(now) > 0 && !*event.Processed {
+		if event.IsRecurring() {
+			occurrences, err := event.DueOccurrences(now)
+			if err != nil {
+				return 0, errors.Wrap(err, "[memorystorage::EnqueueNotifications]")
+			}
+
+			if len(occurrences) > 0 {
+				notifiedUntil[event] = occurrences[len(occurrences)-1].StartsAt
+				due = append(due, occurrences...)
+			}
+
+			continue
+		}
+
+		processed := event.Processed != nil && *event.Processed
+		if event.StartsAt.Sub(now) <= event.NotifyInterval && event.EndsAt.Sub(now) > 0 && !processed {
+			due = append(due, event)
+		}
+	}
+
+	// изменения применяются только после успешного обхода, чтобы ошибка не оставила outbox неполным
+	processed := true
+	for _, event := range due {
+		if !event.IsRecurring() {
 			event.Processed = &processed
-			result = append(result, event)
+		}
+
+		recipients := *event
+		recipients.Attendees = r.attendees[event.ID]
+
+		for _, notification := range storage.NewNotifications(&recipients, now) {
+			if _, exists := r.queued[keyOf(notification)]; exists {
+				continue
+			}
+
+			r.queued[keyOf(notification)] = struct{}{}
+			r.outboxSeq++
+			notification.ID = r.outboxSeq
+			r.outbox = append(r.outbox, notification)
+		}
+	}
+
+	for event, startsAt := range notifiedUntil {
+		event.NotifiedOccurrenceAt = startsAt
+	}
+
+	return len(due), nil
+}
+
+// ReadPendingNotifications читает до limit неотправленных уведомлений из outbox в порядке создания.
+func (r *Repository) ReadPendingNotifications(_ context.Context, limit int) ([]*storage.Notification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var result []*storage.Notification
+	for _, notification := range r.outbox {
+		if len(result) == limit {
+			break
+		}
+
+		if notification.SentAt == nil {
+			copied := *notification
+			result = append(result, &copied)
 		}
 	}
 
 	return result, nil
 }
+
+// MarkNotificationsSent отмечает уведомления outbox как отправленные.
+func (r *Repository) MarkNotificationsSent(_ context.Context, ids []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+
+	for _, notification := range r.outbox {
+		if notification.SentAt == nil && slices.Contains(ids, notification.ID) {
+			notification.SentAt = &now
+		}
+	}
+
+	return nil
+}
+
+// dropNotifications удаляет из outbox уведомления о событиях, для которых drop возвращает true,
+// повторяя поведение "ON DELETE CASCADE" в postgres.
+func (r *Repository) dropNotifications(drop func(eventID string) bool) {
+	r.outbox = slices.DeleteFunc(r.outbox, func(notification *storage.Notification) bool {
+		if !drop(notification.EventID) {
+			return false
+		}
+
+		delete(r.queued, keyOf(notification))
+
+		return true
+	})
+}
+
+// DeleteEventsEndedBefore удаляет события, закончившиеся раньше указанного момента.
+// Повторяющиеся события удаляются после окончания последнего вхождения, бесконечные серии не удаляются.
+func (r *Repository) DeleteEventsEndedBefore(_ context.Context, before time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	expired := make(map[string]struct{})
+	for id, event := range r.eventsByID {
+		end, err := event.RecurrenceEnd()
+		if err != nil {
+			return 0, errors.Wrap(err, "[memorystorage::DeleteEventsEndedBefore]")
+		}
+
+		if end != nil && end.Before(before) {
+			expired[id] = struct{}{}
+		}
+	}
+
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	r.removeEvents(expired)
+
+	return int64(len(expired)), nil
+}
+
+// removeEvents удаляет события с указанными ID вместе с их участниками и уведомлениями,
+// повторяя поведение "ON DELETE CASCADE" в postgres. Вызывается под мьютексом на запись.
+func (r *Repository) removeEvents(ids map[string]struct{}) {
+	isRemoved := func(event *storage.Event) bool {
+		_, ok := ids[event.ID]
+		return ok
+	}
+
+	users := make(map[string]struct{})
+	for id := range ids {
+		users[r.eventsByID[id].UserID] = struct{}{}
+		r.unindex(r.eventsByID[id])
+		delete(r.eventsByID, id)
+		delete(r.attendees, id)
+	}
+
+	for userID := range users {
+		if userEvents := slices.DeleteFunc(r.eventsByUser[userID], isRemoved); len(userEvents) > 0 {
+			r.eventsByUser[userID] = userEvents
+		} else {
+			delete(r.eventsByUser, userID)
+		}
+	}
+
+	r.sortedEvents = slices.DeleteFunc(r.sortedEvents, isRemoved)
+
+	r.dropNotifications(func(eventID string) bool {
+		_, ok := ids[eventID]
+		return ok
+	})
+}
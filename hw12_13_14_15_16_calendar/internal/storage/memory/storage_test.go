@@ -20,6 +20,9 @@ func cleanup(repo *Repository) {
 	repo.eventsByUser = make(map[string][]*storage.Event)
 	repo.eventsByID = make(map[string]*storage.Event)
 	repo.sortedEvents = make([]*storage.Event, 0)
+	repo.terms = make(map[string]map[string]int)
+	repo.attendees = make(map[string][]*storage.Attendee)
+	repo.calendars = make(map[string]*storage.Calendar)
 }
 
 func TestStorage(t *testing.T) {
@@ -118,6 +121,21 @@ func TestStorage(t *testing.T) {
 		require.Equal(t, eventUpd, repo.sortedEvents[0])
 	})
 
+	t.Run("duplicate ical uid rejected per user", func(t *testing.T) {
+		t.Cleanup(func() {
+			cleanup(repo)
+		})
+
+		event := &storage.Event{StartsAt: ptr(start), EndsAt: ptr(start), UserID: "user", ICalUID: "uid"}
+		require.NoError(t, repo.CreateEvent(context.Background(), event))
+
+		duplicate := &storage.Event{StartsAt: ptr(start), EndsAt: ptr(start), UserID: "user", ICalUID: "uid"}
+		require.ErrorIs(t, repo.CreateEvent(context.Background(), duplicate), storage.ErrEventExists)
+
+		other := &storage.Event{StartsAt: ptr(start), EndsAt: ptr(start), UserID: "other", ICalUID: "uid"}
+		require.NoError(t, repo.CreateEvent(context.Background(), other))
+	})
+
 	t.Run("read events", func(t *testing.T) {
 		t.Cleanup(func() {
 			cleanup(repo)
@@ -156,21 +174,576 @@ func TestStorage(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		dailyEvents, err := repo.ReadDailyEvents(context.Background(), userID, start)
+		dailyEvents, err := repo.ReadDailyEvents(context.Background(), userID, start, nil)
 		require.NoError(t, err)
 		require.Len(t, dailyEvents, 1)
 		require.Equal(t, events[0], dailyEvents[0])
 
-		dailyEvents, err = repo.ReadWeeklyEvents(context.Background(), userID, start)
+		dailyEvents, err = repo.ReadWeeklyEvents(context.Background(), userID, start, nil)
 		require.NoError(t, err)
 		require.Equal(t, events[:2], dailyEvents)
 
-		dailyEvents, err = repo.ReadMonthlyEvents(context.Background(), userID, start)
+		dailyEvents, err = repo.ReadMonthlyEvents(context.Background(), userID, start, nil)
 		require.NoError(t, err)
 		require.Equal(t, events, dailyEvents)
 	})
 }
 
+func TestStorageRecurringEvents(t *testing.T) {
+	repo := New()
+	ctx := context.Background()
+
+	userID := "user"
+	start := time.Date(2025, time.January, 6, 10, 0, 0, 0, time.UTC) // понедельник
+
+	event := &storage.Event{
+		Title:          "Stand-up",
+		StartsAt:       ptr(start),
+		EndsAt:         ptr(start.Add(15 * time.Minute)),
+		UserID:         userID,
+		NotifyInterval: time.Hour,
+		RRule:          "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=6",
+		ExDates:        []time.Time{start.AddDate(0, 0, 2)},
+	}
+	require.NoError(t, repo.CreateEvent(ctx, event))
+
+	t.Run("daily read expands single occurrence", func(t *testing.T) {
+		events, err := repo.ReadDailyEvents(ctx, userID, start.AddDate(0, 0, 7), nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, event.ID, events[0].ID)
+		require.Equal(t, start.AddDate(0, 0, 7), *events[0].StartsAt)
+		require.Equal(t, start.AddDate(0, 0, 7).Add(15*time.Minute), *events[0].EndsAt)
+	})
+
+	t.Run("weekly read skips exdates", func(t *testing.T) {
+		events, err := repo.ReadWeeklyEvents(ctx, userID, start, nil)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, start, *events[0].StartsAt)
+	})
+
+	t.Run("monthly read respects count", func(t *testing.T) {
+		events, err := repo.ReadMonthlyEvents(ctx, userID, start, nil)
+		require.NoError(t, err)
+		require.Len(t, events, 5)
+		require.Equal(t, start.AddDate(0, 0, 16), *events[len(events)-1].StartsAt)
+	})
+
+	t.Run("notify once per occurrence", func(t *testing.T) {
+		now := time.Now().UTC()
+		soon := now.Add(30 * time.Minute)
+
+		daily := &storage.Event{
+			Title:          "Daily",
+			StartsAt:       ptr(soon.AddDate(0, 0, -3)),
+			EndsAt:         ptr(soon.AddDate(0, 0, -3).Add(time.Minute)),
+			UserID:         userID,
+			NotifyInterval: time.Hour,
+			RRule:          "FREQ=DAILY",
+		}
+		require.NoError(t, repo.CreateEvent(ctx, daily))
+
+		enqueued, err := repo.EnqueueNotifications(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, enqueued)
+
+		notifications, err := repo.ReadPendingNotifications(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		require.Equal(t, daily.ID, notifications[0].EventID)
+		require.True(t, soon.Equal(notifications[0].StartsAt))
+
+		enqueued, err = repo.EnqueueNotifications(ctx)
+		require.NoError(t, err)
+		require.Zero(t, enqueued)
+
+		// правка серии не сбрасывает уже отправленное уведомление о текущем вхождении
+		updated := *daily
+		updated.Title = "Daily sync"
+		updated.NotifiedOccurrenceAt = nil
+		require.NoError(t, repo.UpdateEvent(ctx, &updated))
+
+		enqueued, err = repo.EnqueueNotifications(ctx)
+		require.NoError(t, err)
+		require.Zero(t, enqueued)
+
+		notifications, err = repo.ReadPendingNotifications(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+	})
+}
+
+func TestStorageOutbox(t *testing.T) {
+	repo := New()
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	for i := range 3 {
+		require.NoError(t, repo.CreateEvent(ctx, &storage.Event{
+			Title:          "Title" + strconv.Itoa(i),
+			StartsAt:       ptr(now.Add(time.Duration(i+1) * time.Minute)),
+			EndsAt:         ptr(now.Add(time.Hour)),
+			UserID:         "user",
+			NotifyInterval: time.Hour,
+			Processed:      ptr(false),
+		}))
+	}
+
+	enqueued, err := repo.EnqueueNotifications(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, enqueued)
+
+	t.Run("pending notifications survive until marked sent", func(t *testing.T) {
+		pending, err := repo.ReadPendingNotifications(ctx, 2)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.Less(t, pending[0].ID, pending[1].ID)
+
+		// публикация не подтверждена - уведомления остаются в outbox
+		again, err := repo.ReadPendingNotifications(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, again, 3)
+
+		require.NoError(t, repo.MarkNotificationsSent(ctx, []int64{pending[0].ID, pending[1].ID}))
+
+		again, err = repo.ReadPendingNotifications(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, again, 1)
+	})
+
+	t.Run("deleting event drops its notifications", func(t *testing.T) {
+		pending, err := repo.ReadPendingNotifications(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+
+		require.NoError(t, repo.DeleteEvent(ctx, pending[0].EventID))
+
+		pending, err = repo.ReadPendingNotifications(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+}
+
+func TestStorageBusyConflicts(t *testing.T) {
+	repo := New()
+	ctx := context.Background()
+
+	userID := "user"
+	start := time.Date(2025, time.January, 6, 10, 0, 0, 0, time.UTC) // понедельник
+
+	busy := &storage.Event{
+		Title:    "Meeting",
+		StartsAt: ptr(start),
+		EndsAt:   ptr(start.Add(time.Hour)),
+		UserID:   userID,
+		Busy:     true,
+	}
+	require.NoError(t, repo.CreateEvent(ctx, busy))
+
+	t.Run("overlapping busy event rejected", func(t *testing.T) {
+		err := repo.CreateEvent(ctx, &storage.Event{
+			StartsAt: ptr(start.Add(30 * time.Minute)),
+			EndsAt:   ptr(start.Add(90 * time.Minute)),
+			UserID:   userID,
+			Busy:     true,
+		})
+		require.ErrorIs(t, err, storage.ErrDateBusy)
+	})
+
+	t.Run("adjacent, non-busy and other user's events allowed", func(t *testing.T) {
+		require.NoError(t, repo.CreateEvent(ctx, &storage.Event{
+			StartsAt: ptr(start.Add(time.Hour)),
+			EndsAt:   ptr(start.Add(2 * time.Hour)),
+			UserID:   userID,
+			Busy:     true,
+		}))
+		require.NoError(t, repo.CreateEvent(ctx, &storage.Event{
+			StartsAt:  ptr(start),
+			EndsAt:    ptr(start.Add(time.Hour)),
+			UserID:    userID,
+			Tentative: true,
+		}))
+		require.NoError(t, repo.CreateEvent(ctx, &storage.Event{
+			StartsAt: ptr(start),
+			EndsAt:   ptr(start.Add(time.Hour)),
+			UserID:   "other",
+			Busy:     true,
+		}))
+	})
+
+	t.Run("recurring event conflicts by occurrence", func(t *testing.T) {
+		weekly := &storage.Event{
+			StartsAt: ptr(start.AddDate(0, 0, -7)),
+			EndsAt:   ptr(start.AddDate(0, 0, -7).Add(30 * time.Minute)),
+			UserID:   userID,
+			RRule:    "FREQ=WEEKLY",
+			Busy:     true,
+		}
+		require.ErrorIs(t, repo.CreateEvent(ctx, weekly), storage.ErrDateBusy)
+
+		weekly.ExDates = []time.Time{start}
+		require.NoError(t, repo.CreateEvent(ctx, weekly))
+	})
+
+	t.Run("update does not conflict with itself", func(t *testing.T) {
+		updated := *busy
+		updated.EndsAt = ptr(start.Add(45 * time.Minute))
+		require.NoError(t, repo.UpdateEvent(ctx, &updated))
+
+		updated.EndsAt = ptr(start.Add(61 * time.Minute))
+		require.ErrorIs(t, repo.UpdateEvent(ctx, &updated), storage.ErrDateBusy)
+	})
+}
+
+func TestStorageUpdateThenEnqueue(t *testing.T) {
+	repo := New()
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+
+	event := &storage.Event{
+		Title:          "Standup",
+		StartsAt:       ptr(now.Add(30 * time.Minute)),
+		EndsAt:         ptr(now.Add(time.Hour)),
+		UserID:         "user",
+		NotifyInterval: time.Hour,
+	}
+	require.NoError(t, repo.CreateEvent(ctx, event))
+
+	enqueued, err := repo.EnqueueNotifications(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, enqueued)
+
+	t.Run("update keeps the event processed", func(t *testing.T) {
+		updated := *event
+		updated.Title = "Renamed standup"
+		updated.Processed = nil
+		require.NoError(t, repo.UpdateEvent(ctx, &updated))
+
+		enqueued, err := repo.EnqueueNotifications(ctx)
+		require.NoError(t, err)
+		require.Zero(t, enqueued)
+
+		pending, err := repo.ReadPendingNotifications(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+	})
+
+	t.Run("queued reminder is not duplicated", func(t *testing.T) {
+		updated := *event
+		updated.Processed = ptr(false)
+		require.NoError(t, repo.UpdateEvent(ctx, &updated))
+
+		enqueued, err := repo.EnqueueNotifications(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, enqueued)
+
+		pending, err := repo.ReadPendingNotifications(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+	})
+}
+
+func TestStorageReadEventsInRange(t *testing.T) {
+	repo := New()
+	ctx := context.Background()
+
+	start := time.Date(2025, time.January, 6, 10, 0, 0, 0, time.UTC)
+
+	for _, userID := range []string{"alice", "bob", "carol"} {
+		require.NoError(t, repo.CreateEvent(ctx, &storage.Event{
+			StartsAt: ptr(start),
+			EndsAt:   ptr(start.Add(time.Hour)),
+			UserID:   userID,
+		}))
+	}
+
+	require.NoError(t, repo.CreateEvent(ctx, &storage.Event{
+		StartsAt: ptr(start.AddDate(0, 0, -1)),
+		EndsAt:   ptr(start.AddDate(0, 0, -1).Add(time.Hour)),
+		UserID:   "alice",
+		RRule:    "FREQ=DAILY;COUNT=3",
+	}))
+
+	events, err := repo.ReadEventsInRange(ctx, []string{"alice", "bob"}, start, start.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+
+	for _, event := range events {
+		require.Contains(t, []string{"alice", "bob"}, event.UserID)
+	}
+}
+
+func TestStorageListEvents(t *testing.T) {
+	repo := New()
+	ctx := context.Background()
+
+	start := time.Date(2025, time.January, 6, 10, 0, 0, 0, time.UTC)
+
+	// два события начинаются одновременно, их порядок определяет ID
+	for i, offset := range []int{0, 1, 1, 2, 3} {
+		startsAt := start.Add(time.Duration(offset) * time.Hour)
+
+		require.NoError(t, repo.CreateEvent(ctx, &storage.Event{
+			Title:    "Meeting" + strconv.Itoa(i),
+			StartsAt: ptr(startsAt),
+			EndsAt:   ptr(startsAt.Add(30 * time.Minute)),
+			UserID:   "alice",
+		}))
+	}
+
+	require.NoError(t, repo.CreateEvent(ctx, &storage.Event{
+		Title:    "Meeting",
+		StartsAt: ptr(start),
+		EndsAt:   ptr(start.Add(time.Hour)),
+		UserID:   "bob",
+	}))
+
+	require.NoError(t, repo.CreateEvent(ctx, &storage.Event{
+		Title:       "Standup",
+		Description: "Daily SYNC",
+		StartsAt:    ptr(start.AddDate(0, 0, -5)),
+		EndsAt:      ptr(start.AddDate(0, 0, -5).Add(15 * time.Minute)),
+		UserID:      "alice",
+		RRule:       "FREQ=DAILY;COUNT=3",
+	}))
+
+	// listAll читает все страницы, продолжая выборку с курсора последнего события
+	listAll := func(query storage.ListEventsQuery) []string {
+		var titles []string
+
+		for {
+			page, err := repo.ListEvents(ctx, &query)
+			require.NoError(t, err)
+
+			for _, event := range page {
+				titles = append(titles, event.Title)
+			}
+
+			if len(page) < query.Limit {
+				return titles
+			}
+
+			cursor := page[len(page)-1].Cursor()
+			query.After = &cursor
+		}
+	}
+
+	alice := slices.Clone(repo.eventsByUser["alice"])
+	slices.SortFunc(alice, func(a, b *storage.Event) int {
+		return a.Cursor().Compare(b.Cursor())
+	})
+
+	var expected []string
+	for _, event := range alice {
+		if !event.IsRecurring() {
+			expected = append(expected, event.Title)
+		}
+	}
+
+	t.Run("pages in both orders", func(t *testing.T) {
+		query := storage.ListEventsQuery{UserID: "alice", From: start, Limit: 2}
+		require.Equal(t, expected, listAll(query))
+
+		query.Order = storage.SortOrderDesc
+		desc := listAll(query)
+		slices.Reverse(desc)
+		require.Equal(t, expected, desc)
+	})
+
+	t.Run("range and text filters", func(t *testing.T) {
+		titles := listAll(storage.ListEventsQuery{
+			UserID: "alice",
+			From:   start.Add(time.Hour),
+			To:     start.Add(3 * time.Hour),
+			Limit:  10,
+		})
+		require.Equal(t, expected[1:4], titles)
+
+		titles = listAll(storage.ListEventsQuery{UserID: "alice", Query: "sync", Limit: 10})
+		require.Equal(t, []string{"Standup"}, titles)
+	})
+}
+
+func TestStorageSearchEvents(t *testing.T) {
+	repo := New()
+	ctx := context.Background()
+
+	start := time.Date(2025, time.January, 6, 10, 0, 0, 0, time.UTC)
+
+	create := func(title, description, userID string) *storage.Event {
+		event := &storage.Event{
+			Title:       title,
+			Description: description,
+			StartsAt:    ptr(start),
+			EndsAt:      ptr(start.Add(time.Hour)),
+			UserID:      userID,
+		}
+		require.NoError(t, repo.CreateEvent(ctx, event))
+
+		return event
+	}
+
+	search := func(query string) []string {
+		events, err := repo.SearchEvents(ctx, "alice", query, nil, 10)
+		require.NoError(t, err)
+
+		titles := make([]string, 0, len(events))
+		for _, event := range events {
+			titles = append(titles, event.Title)
+		}
+
+		return titles
+	}
+
+	audit := create("Meeting", "Quarterly review with the Auditors", "alice")
+	create("Auditors meeting", "", "alice")
+	create("Auditors meeting", "", "bob")
+	create("Lunch", "", "alice")
+
+	t.Run("any word matches, more matches rank higher", func(t *testing.T) {
+		require.Equal(t, []string{"Auditors meeting", "Meeting"}, search("meeting AUDITORS"))
+		require.Equal(t, []string{"Meeting", "Auditors meeting"}, search("quarterly review auditors"))
+		require.Equal(t, []string{"Auditors meeting", "Meeting"}, search("auditors dinner"))
+		require.Empty(t, search("dinner"))
+		require.Empty(t, search("  "))
+	})
+
+	t.Run("natural language query", func(t *testing.T) {
+		require.ElementsMatch(t, []string{"Auditors meeting", "Meeting"}, search("that meeting with the auditors"))
+	})
+
+	t.Run("index follows updates and deletes", func(t *testing.T) {
+		updated := *audit
+		updated.Description = "Budget planning"
+		require.NoError(t, repo.UpdateEvent(ctx, &updated))
+
+		require.Empty(t, search("quarterly"))
+		require.Equal(t, []string{"Meeting"}, search("budget"))
+
+		require.NoError(t, repo.DeleteEvent(ctx, audit.ID))
+		require.Empty(t, search("budget"))
+		require.NotContains(t, repo.terms, "budget")
+	})
+}
+
+func TestStorageDeleteEventsEndedBefore(t *testing.T) {
+	repo := New()
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	old := now.AddDate(-2, 0, 0)
+
+	for _, event := range []*storage.Event{
+		{StartsAt: ptr(old), EndsAt: ptr(old.Add(time.Hour)), UserID: "user"},
+		{StartsAt: ptr(old), EndsAt: ptr(old.Add(time.Hour)), UserID: "other"},
+		{StartsAt: ptr(old), EndsAt: ptr(old.Add(time.Hour)), UserID: "user", RRule: "FREQ=DAILY;COUNT=3"},
+		{StartsAt: ptr(old), EndsAt: ptr(old.Add(time.Hour)), UserID: "user", RRule: "FREQ=YEARLY"},
+		{StartsAt: ptr(now), EndsAt: ptr(now.Add(time.Hour)), UserID: "user"},
+	} {
+		require.NoError(t, repo.CreateEvent(ctx, event))
+	}
+
+	deleted, err := repo.DeleteEventsEndedBefore(ctx, now.AddDate(-1, 0, 0))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), deleted)
+	require.Len(t, repo.eventsByID, 2)
+	require.Len(t, repo.sortedEvents, 2)
+	require.Len(t, repo.eventsByUser["user"], 2)
+	require.NotContains(t, repo.eventsByUser, "other")
+
+	deleted, err = repo.DeleteEventsEndedBefore(ctx, now.AddDate(-1, 0, 0))
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+}
+
+func TestStorageAttendees(t *testing.T) {
+	repo := New()
+	ctx := context.Background()
+
+	startsAt := time.Now().UTC().Add(time.Minute)
+	event := &storage.Event{
+		Title:          "Planning",
+		StartsAt:       ptr(startsAt),
+		EndsAt:         ptr(startsAt.Add(time.Hour)),
+		UserID:         "alice",
+		NotifyInterval: time.Hour,
+		Processed:      ptr(false),
+	}
+	require.NoError(t, repo.CreateEvent(ctx, event))
+
+	for _, userID := range []string{"carol", "bob"} {
+		attendee := &storage.Attendee{
+			EventID: event.ID,
+			UserID:  userID,
+			Role:    storage.AttendeeRoleRequired,
+			Status:  storage.RSVPStatusNeedsAction,
+		}
+		require.NoError(t, repo.SaveAttendee(ctx, attendee))
+	}
+
+	readDaily := func(userID string) []*storage.Event {
+		events, err := repo.ReadDailyEvents(ctx, userID, startsAt, nil)
+		require.NoError(t, err)
+
+		return events
+	}
+
+	t.Run("accepted events are visible to attendee", func(t *testing.T) {
+		require.Empty(t, readDaily("bob"))
+
+		require.NoError(t, repo.SetAttendeeStatus(ctx, event.ID, "bob", storage.RSVPStatusAccepted))
+		require.NoError(t, repo.SetAttendeeStatus(ctx, event.ID, "carol", storage.RSVPStatusDeclined))
+
+		events := readDaily("bob")
+		require.Len(t, events, 1)
+		require.Equal(t, "alice", events[0].UserID)
+		require.Len(t, events[0].Attendees, 2)
+		require.Equal(t, "bob", events[0].Attendees[0].UserID)
+
+		require.Empty(t, readDaily("carol"))
+
+		err := repo.SetAttendeeStatus(ctx, event.ID, "dave", storage.RSVPStatusAccepted)
+		require.ErrorIs(t, err, storage.ErrAttendeeNotFound)
+	})
+
+	t.Run("invitations", func(t *testing.T) {
+		invitations, err := repo.ListInvitations(ctx, "carol")
+		require.NoError(t, err)
+		require.Len(t, invitations, 1)
+		require.Equal(t, event.ID, invitations[0].ID)
+
+		invitations, err = repo.ListInvitations(ctx, "alice")
+		require.NoError(t, err)
+		require.Empty(t, invitations)
+	})
+
+	t.Run("reminders skip declined attendees", func(t *testing.T) {
+		_, err := repo.EnqueueNotifications(ctx)
+		require.NoError(t, err)
+
+		pending, err := repo.ReadPendingNotifications(ctx, 10)
+		require.NoError(t, err)
+
+		recipients := make([]string, 0, len(pending))
+		for _, notification := range pending {
+			recipients = append(recipients, notification.UserID)
+		}
+
+		require.ElementsMatch(t, []string{"alice", "bob"}, recipients)
+	})
+
+	t.Run("deleting event drops attendees", func(t *testing.T) {
+		require.NoError(t, repo.DeleteEvent(ctx, event.ID))
+
+		invitations, err := repo.ListInvitations(ctx, "bob")
+		require.NoError(t, err)
+		require.Empty(t, invitations)
+	})
+}
+
 func TestStorageMultithreading(_ *testing.T) {
 	repo := New()
 	ctx := context.Background()
@@ -192,7 +765,7 @@ func TestStorageMultithreading(_ *testing.T) {
 
 	readFunc := func() {
 		for range 1000 {
-			_, _ = repo.ReadDailyEvents(ctx, userID, start)
+			_, _ = repo.ReadDailyEvents(ctx, userID, start, nil)
 		}
 	}
 
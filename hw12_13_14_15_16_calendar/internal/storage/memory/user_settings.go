@@ -0,0 +1,21 @@
+package memorystorage
+
+import "context"
+
+// SetUserTimeZone сохраняет IANA часовой пояс пользователя.
+func (r *Repository) SetUserTimeZone(_ context.Context, userID, timeZone string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.timeZones[userID] = timeZone
+
+	return nil
+}
+
+// ReadUserTimeZone читает IANA часовой пояс пользователя. Если пояс не задан, возвращает пустую строку.
+func (r *Repository) ReadUserTimeZone(_ context.Context, userID string) (string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.timeZones[userID], nil
+}
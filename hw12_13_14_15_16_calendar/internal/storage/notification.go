@@ -0,0 +1,40 @@
+package storage
+
+import "time"
+
+// Notification запись outbox с уведомлением о наступающем событии или вхождении серии.
+// Запись создается в одной транзакции с отметкой события как обработанного и считается
+// доставленной только после подтверждения публикации брокером (SentAt).
+type Notification struct {
+	ID        int64      `db:"id"`
+	EventID   string     `db:"event_id"`
+	Title     string     `db:"title"`
+	StartsAt  time.Time  `db:"starts_at"`
+	UserID    string     `db:"user_id"`
+	CreatedAt time.Time  `db:"created_at"`
+	SentAt    *time.Time `db:"sent_at"`
+}
+
+// NewNotifications создает записи outbox для события: владельцу и каждому участнику,
+// который не отказался от приглашения.
+func NewNotifications(event *Event, now time.Time) []*Notification {
+	notifications := []*Notification{newNotification(event, event.UserID, now)}
+
+	for _, attendee := range event.Attendees {
+		if attendee.Reminded() {
+			notifications = append(notifications, newNotification(event, attendee.UserID, now))
+		}
+	}
+
+	return notifications
+}
+
+func newNotification(event *Event, userID string, now time.Time) *Notification {
+	return &Notification{
+		EventID:   event.ID,
+		Title:     event.Title,
+		StartsAt:  *event.StartsAt,
+		UserID:    userID,
+		CreatedAt: now,
+	}
+}
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"slices"
+	"time"
+
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/pkg/rrule"
+	"github.com/pkg/errors" //nolint:depguard
+)
+
+// IsRecurring сообщает, является ли событие повторяющимся.
+func (e *Event) IsRecurring() bool {
+	return e.RRule != ""
+}
+
+// Occurrences возвращает вхождения события, пересекающиеся с интервалом [from, to).
+// Вхождение - копия события со сдвинутыми StartsAt и EndsAt.
+func (e *Event) Occurrences(from, to time.Time) ([]*Event, error) {
+	if !e.IsRecurring() {
+		if e.StartsAt.Before(to) && e.EndsAt.After(from) {
+			return []*Event{e}, nil
+		}
+		return nil, nil
+	}
+
+	rule, err := rrule.Parse(e.RRule)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[storage::Occurrences]: event %q", e.ID)
+	}
+
+	duration := e.EndsAt.Sub(*e.StartsAt)
+
+	var result []*Event
+	rule.Iterate(*e.StartsAt, func(start time.Time) bool {
+		if !start.Before(to) {
+			return false
+		}
+
+		end := start.Add(duration)
+		if end.After(from) && !e.isExcluded(start) {
+			occurrence := *e
+			occurrence.StartsAt = &start
+			occurrence.EndsAt = &end
+			result = append(result, &occurrence)
+		}
+
+		return true
+	})
+
+	return result, nil
+}
+
+// RecurrenceEnd возвращает окончание последнего вхождения события; nil для бесконечной серии.
+func (e *Event) RecurrenceEnd() (*time.Time, error) {
+	if !e.IsRecurring() {
+		return e.EndsAt, nil
+	}
+
+	rule, err := rrule.Parse(e.RRule)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[storage::RecurrenceEnd]: event %q", e.ID)
+	}
+
+	last, ok := rule.Last(*e.StartsAt)
+	if !ok {
+		return nil, nil
+	}
+
+	end := last.Add(e.EndsAt.Sub(*e.StartsAt))
+
+	return &end, nil
+}
+
+// DueOccurrences возвращает вхождения повторяющегося события, о которых пора уведомить на момент now
+// и о которых еще не уведомляли (начинаются позже NotifiedOccurrenceAt).
+func (e *Event) DueOccurrences(now time.Time) ([]*Event, error) {
+	occurrences, err := e.Occurrences(now, now.Add(e.NotifyInterval+time.Nanosecond))
+	if err != nil {
+		return nil, errors.Wrap(err, "[storage::DueOccurrences]")
+	}
+
+	return slices.DeleteFunc(occurrences, func(o *Event) bool {
+		return e.NotifiedOccurrenceAt != nil && !o.StartsAt.After(*e.NotifiedOccurrenceAt)
+	}), nil
+}
+
+func (e *Event) isExcluded(start time.Time) bool {
+	return slices.ContainsFunc(e.ExDates, start.Equal)
+}
+
+// ExpandEvents разворачивает события во вхождения, пересекающиеся с интервалом [from, to),
+// и сортирует их по времени начала.
+func ExpandEvents(events []*Event, from, to time.Time) ([]*Event, error) {
+	result := make([]*Event, 0, len(events))
+
+	for _, event := range events {
+		occurrences, err := event.Occurrences(from, to)
+		if err != nil {
+			return nil, errors.Wrap(err, "[storage::ExpandEvents]")
+		}
+
+		result = append(result, occurrences...)
+	}
+
+	slices.SortStableFunc(result, func(i, j *Event) int {
+		return i.StartsAt.Compare(*j.StartsAt)
+	})
+
+	return result, nil
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Tokenize разбивает текст на слова в нижнем регистре.
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// SearchTerms возвращает уникальные слова поискового запроса. Событие подходит под запрос,
+// если содержит хотя бы одно из них, а события с большим числом совпадений релевантнее:
+// так находится и запрос на естественном языке со словами, которых нет в событии.
+func SearchTerms(query string) []string {
+	terms := Tokenize(query)
+
+	slices.Sort(terms)
+
+	return slices.Compact(terms)
+}
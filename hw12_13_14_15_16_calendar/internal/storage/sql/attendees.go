@@ -0,0 +1,154 @@
+package sqlstorage
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const attendeesTable = "event_attendees"
+
+var attendeeColumns = []string{"event_id", "user_id", "role", "status"}
+
+// SaveAttendee сохраняет участника события. У уже приглашенного участника обновляется только роль.
+func (r *Repository) SaveAttendee(ctx context.Context, attendee *storage.Attendee) error {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(attendeesTable).
+		Columns(attendeeColumns...).
+		Values(attendee.EventID, attendee.UserID, attendee.Role, attendee.Status).
+		Suffix("ON CONFLICT (event_id, user_id) DO UPDATE SET role = EXCLUDED.role")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::SaveAttendee]: can't build sql query")
+	}
+
+	if _, err = r.pool.Exec(ctx, query, args...); err != nil {
+		if isForeignKeyViolation(err) {
+			return errors.Wrapf(storage.ErrEventNotFound,
+				"[sqlstorage::SaveAttendee]: event with ID %s", attendee.EventID)
+		}
+
+		return errors.Wrap(err, "[sqlstorage::SaveAttendee]: can't execute sql query")
+	}
+
+	return nil
+}
+
+// SetAttendeeStatus сохраняет ответ участника userID на приглашение на событие eventID.
+func (r *Repository) SetAttendeeStatus(
+	ctx context.Context,
+	eventID, userID string,
+	status storage.RSVPStatus,
+) error {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(attendeesTable).
+		Set("status", status).
+		Where(sq.Eq{"event_id": eventID, "user_id": userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::SetAttendeeStatus]: can't build sql query")
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::SetAttendeeStatus]: can't execute sql query")
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(storage.ErrAttendeeNotFound,
+			"[sqlstorage::SetAttendeeStatus]: user %s in event %s", userID, eventID)
+	}
+
+	return nil
+}
+
+// ListInvitations читает события, на которые приглашен пользователь, упорядоченные по началу.
+func (r *Repository) ListInvitations(ctx context.Context, userID string) ([]*storage.Event, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(eventColumns...).
+		From(eventsTable).
+		Where(sq.Expr("id IN (SELECT event_id FROM "+attendeesTable+" WHERE user_id = ?)", userID)).
+		OrderBy("starts_at", "id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ListInvitations]: can't build sql query")
+	}
+
+	var events []*storage.Event
+
+	if err = pgxscan.Select(ctx, r.pool, &events, query, args...); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ListInvitations]: can't execute sql query")
+	}
+
+	if err = loadAttendees(ctx, r.pool, events); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ListInvitations]")
+	}
+
+	return events, nil
+}
+
+// visibleTo возвращает условие на события календарей calendarIDs, которые пользователь видит
+// в своем календаре: собственные и те, приглашение на которые он принял.
+func visibleTo(userID string, calendarIDs []string) sq.Sqlizer {
+	where := sq.And{sq.Or{
+		sq.Eq{"user_id": userID},
+		sq.Expr("id IN (SELECT event_id FROM "+attendeesTable+" WHERE user_id = ? AND status = ?)",
+			userID, storage.RSVPStatusAccepted),
+	}}
+
+	if len(calendarIDs) > 0 {
+		where = append(where, sq.Eq{"calendar_id": calendarIDs})
+	}
+
+	return where
+}
+
+// loadAttendees заполняет участников событий одним запросом. Вхождения одной серии
+// получают общий список участников.
+func loadAttendees(ctx context.Context, querier pgxscan.Querier, events []*storage.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	eventsByID := make(map[string][]*storage.Event, len(events))
+	ids := make([]string, 0, len(events))
+
+	for _, event := range events {
+		if _, ok := eventsByID[event.ID]; !ok {
+			ids = append(ids, event.ID)
+		}
+
+		eventsByID[event.ID] = append(eventsByID[event.ID], event)
+	}
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(attendeeColumns...).
+		From(attendeesTable).
+		Where(sq.Eq{"event_id": ids}).
+		OrderBy("event_id", "user_id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "can't build sql query")
+	}
+
+	var attendees []*storage.Attendee
+
+	if err = pgxscan.Select(ctx, querier, &attendees, query, args...); err != nil {
+		return errors.Wrap(err, "can't execute sql query")
+	}
+
+	for _, attendee := range attendees {
+		for _, event := range eventsByID[attendee.EventID] {
+			event.Attendees = append(event.Attendees, attendee)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package sqlstorage
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const calendarsTable = "calendars"
+
+var calendarColumns = []string{"id", "user_id", "name", "color", "default_notify_interval", "is_default", "created_at"}
+
+// CreateCalendar сохраняет календарь и проставляет ему ID. Второй календарь по умолчанию
+// отклоняется уникальным индексом calendars_user_id_default_idx.
+func (r *Repository) CreateCalendar(ctx context.Context, calendar *storage.Calendar) error {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(calendarsTable).
+		Columns(calendarColumns[1:]...).
+		Values(
+			calendar.UserID, calendar.Name, calendar.Color, calendar.DefaultNotifyInterval, calendar.IsDefault,
+			calendar.CreatedAt,
+		).
+		Suffix("RETURNING id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::CreateCalendar]: can't build sql query")
+	}
+
+	if err = r.pool.QueryRow(ctx, query, args...).Scan(&calendar.ID); err != nil {
+		if isUniqueViolation(err) {
+			return errors.Wrapf(storage.ErrCalendarExists, "[sqlstorage::CreateCalendar]: user %s", calendar.UserID)
+		}
+
+		return errors.Wrap(err, "[sqlstorage::CreateCalendar]: can't execute sql query")
+	}
+
+	return nil
+}
+
+// UpdateCalendar обновляет название, цвет и напоминание по умолчанию календаря.
+func (r *Repository) UpdateCalendar(ctx context.Context, calendar *storage.Calendar) error {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(calendarsTable).
+		Set("name", calendar.Name).
+		Set("color", calendar.Color).
+		Set("default_notify_interval", calendar.DefaultNotifyInterval).
+		Where(sq.Eq{"id": calendar.ID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::UpdateCalendar]: can't build sql query")
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::UpdateCalendar]: can't execute sql query")
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(storage.ErrCalendarNotFound, "[sqlstorage::UpdateCalendar]: calendar with ID %s", calendar.ID)
+	}
+
+	return nil
+}
+
+// DeleteCalendar удаляет календарь; его события удаляются каскадно. Удаленные события вместе
+// с участниками читаются в той же транзакции до удаления и возвращаются.
+func (r *Repository) DeleteCalendar(ctx context.Context, calendarID string) ([]*storage.Event, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::DeleteCalendar]: can't begin transaction")
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	selectBuilder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(eventColumns...).
+		From(eventsTable).
+		Where(sq.Eq{"calendar_id": calendarID}).
+		OrderBy("starts_at", "id").
+		Suffix("FOR UPDATE")
+
+	query, args, err := selectBuilder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::DeleteCalendar]: can't build sql query")
+	}
+
+	var deleted []*storage.Event
+
+	if err = pgxscan.Select(ctx, tx, &deleted, query, args...); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::DeleteCalendar]: can't execute sql query")
+	}
+
+	if err = loadAttendees(ctx, tx, deleted); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::DeleteCalendar]")
+	}
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete(calendarsTable).
+		Where(sq.Eq{"id": calendarID})
+
+	query, args, err = builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::DeleteCalendar]: can't build sql query")
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::DeleteCalendar]: can't execute sql query")
+	}
+
+	if tag.RowsAffected() == 0 {
+		return nil, errors.Wrapf(storage.ErrCalendarNotFound,
+			"[sqlstorage::DeleteCalendar]: calendar with ID %s", calendarID)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::DeleteCalendar]: can't commit transaction")
+	}
+
+	return deleted, nil
+}
+
+// ReadCalendar читает календарь по ID.
+func (r *Repository) ReadCalendar(ctx context.Context, calendarID string) (*storage.Calendar, error) {
+	calendar, err := r.readCalendar(ctx, sq.Eq{"id": calendarID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "[sqlstorage::ReadCalendar]: calendar with ID %s", calendarID)
+	}
+
+	return calendar, nil
+}
+
+// ReadDefaultCalendar читает календарь пользователя по умолчанию.
+func (r *Repository) ReadDefaultCalendar(ctx context.Context, userID string) (*storage.Calendar, error) {
+	calendar, err := r.readCalendar(ctx, sq.Eq{"user_id": userID, "is_default": true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "[sqlstorage::ReadDefaultCalendar]: user %s", userID)
+	}
+
+	return calendar, nil
+}
+
+// ListCalendars читает календари пользователя: сначала календарь по умолчанию, затем по названию.
+func (r *Repository) ListCalendars(ctx context.Context, userID string) ([]*storage.Calendar, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(calendarColumns...).
+		From(calendarsTable).
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("is_default DESC", "name", "id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ListCalendars]: can't build sql query")
+	}
+
+	var calendars []*storage.Calendar
+
+	if err = pgxscan.Select(ctx, r.pool, &calendars, query, args...); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ListCalendars]: can't execute sql query")
+	}
+
+	return calendars, nil
+}
+
+func (r *Repository) readCalendar(ctx context.Context, where sq.Sqlizer) (*storage.Calendar, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(calendarColumns...).
+		From(calendarsTable).
+		Where(where)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "can't build sql query")
+	}
+
+	var calendar storage.Calendar
+
+	if err = pgxscan.Get(ctx, r.pool, &calendar, query, args...); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, storage.ErrCalendarNotFound
+		}
+		return nil, errors.Wrap(err, "can't execute sql query")
+	}
+
+	return &calendar, nil
+}
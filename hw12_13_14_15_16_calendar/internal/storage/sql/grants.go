@@ -0,0 +1,103 @@
+package sqlstorage
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const grantsTable = "calendar_grants"
+
+var grantColumns = []string{"owner_id", "grantee_id", "permission", "created_at"}
+
+// SaveGrant сохраняет разрешение, заменяя прежнее разрешение того же пользователя.
+func (r *Repository) SaveGrant(ctx context.Context, grant *storage.Grant) error {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(grantsTable).
+		Columns(grantColumns...).
+		Values(grant.OwnerID, grant.GranteeID, grant.Permission, grant.CreatedAt).
+		Suffix("ON CONFLICT (owner_id, grantee_id) DO UPDATE SET permission = EXCLUDED.permission")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::SaveGrant]: can't build sql query")
+	}
+
+	if _, err = r.pool.Exec(ctx, query, args...); err != nil {
+		return errors.Wrap(err, "[sqlstorage::SaveGrant]: can't execute sql query")
+	}
+
+	return nil
+}
+
+// DeleteGrant удаляет разрешение пользователю granteeID на календарь ownerID.
+func (r *Repository) DeleteGrant(ctx context.Context, ownerID, granteeID string) error {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete(grantsTable).
+		Where(sq.Eq{"owner_id": ownerID, "grantee_id": granteeID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::DeleteGrant]: can't build sql query")
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::DeleteGrant]: can't execute sql query")
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(storage.ErrGrantNotFound, "[sqlstorage::DeleteGrant]: %s -> %s", ownerID, granteeID)
+	}
+
+	return nil
+}
+
+// ReadGrant читает разрешение пользователю granteeID на календарь ownerID.
+func (r *Repository) ReadGrant(ctx context.Context, ownerID, granteeID string) (*storage.Grant, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(grantColumns...).
+		From(grantsTable).
+		Where(sq.Eq{"owner_id": ownerID, "grantee_id": granteeID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ReadGrant]: can't build sql query")
+	}
+
+	var grant storage.Grant
+
+	if err = pgxscan.Get(ctx, r.pool, &grant, query, args...); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, errors.Wrapf(storage.ErrGrantNotFound, "[sqlstorage::ReadGrant]: %s -> %s", ownerID, granteeID)
+		}
+		return nil, errors.Wrap(err, "[sqlstorage::ReadGrant]: can't execute sql query")
+	}
+
+	return &grant, nil
+}
+
+// ListGrants читает разрешения на календарь ownerID, упорядоченные по пользователю.
+func (r *Repository) ListGrants(ctx context.Context, ownerID string) ([]*storage.Grant, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(grantColumns...).
+		From(grantsTable).
+		Where(sq.Eq{"owner_id": ownerID}).
+		OrderBy("grantee_id")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ListGrants]: can't build sql query")
+	}
+
+	var grants []*storage.Grant
+
+	if err = pgxscan.Select(ctx, r.pool, &grants, query, args...); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ListGrants]: can't execute sql query")
+	}
+
+	return grants, nil
+}
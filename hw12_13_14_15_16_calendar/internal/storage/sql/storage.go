@@ -2,16 +2,32 @@ package sqlstorage
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/devgomax/go-hw-otus/hw12_13_14_15_calendar/internal/storage"
 	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/pkg/errors"
 )
 
-const eventsTable = "events"
+const (
+	eventsTable = "events"
+	outboxTable = "outbox"
+)
+
+// likeEscaper экранирует спецсимволы шаблона LIKE в искомой подстроке.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// purgeBatchSize количество событий, удаляемых одним запросом при очистке старых событий.
+const purgeBatchSize = 1000
+
+var eventColumns = []string{
+	"id", "title", "starts_at", "ends_at", "description", "user_id", "notify_interval", "rrule", "exdates", "ical_uid",
+	"tentative", "busy", "calendar_id",
+}
 
 // Repository модель БД типа sql.
 type Repository struct {
@@ -51,6 +67,12 @@ func (r *Repository) CreateEvent(ctx context.Context, event *storage.Event) erro
 		return errors.Wrap(err, "[sqlstorage::CreateEvent]: can't serialize event")
 	}
 
+	if err = setRecurrence(m, event); err != nil {
+		return errors.Wrap(err, "[sqlstorage::CreateEvent]")
+	}
+
+	setAvailability(m, event)
+
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Insert(eventsTable).
 		SetMap(m)
@@ -60,9 +82,13 @@ func (r *Repository) CreateEvent(ctx context.Context, event *storage.Event) erro
 		return errors.Wrap(err, "[sqlstorage::CreateEvent]: can't build sql query")
 	}
 
-	_, err = r.pool.Exec(ctx, query, args...)
+	err = r.execChecked(ctx, event, query, args...)
 	if err != nil {
-		return errors.Wrap(err, "[sqlstorage::CreateEvent]: can't execute sql query")
+		if isUniqueViolation(err) {
+			return errors.Wrapf(storage.ErrEventExists, "[sqlstorage::CreateEvent]: event with UID %s", event.ICalUID)
+		}
+
+		return errors.Wrap(err, "[sqlstorage::CreateEvent]")
 	}
 
 	return nil
@@ -75,6 +101,12 @@ func (r *Repository) UpdateEvent(ctx context.Context, event *storage.Event) erro
 		return errors.Wrap(err, "[sqlstorage::UpdateEvent]: can't serialize event")
 	}
 
+	if err = setRecurrence(m, event); err != nil {
+		return errors.Wrap(err, "[sqlstorage::UpdateEvent]")
+	}
+
+	setAvailability(m, event)
+
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Update(eventsTable).
 		SetMap(m).
@@ -85,9 +117,105 @@ func (r *Repository) UpdateEvent(ctx context.Context, event *storage.Event) erro
 		return errors.Wrap(err, "[sqlstorage::UpdateEvent]: can't build sql query")
 	}
 
-	_, err = r.pool.Exec(ctx, query, args...)
+	if err = r.execChecked(ctx, event, query, args...); err != nil {
+		return errors.Wrap(err, "[sqlstorage::UpdateEvent]")
+	}
+
+	return nil
+}
+
+// execChecked выполняет запрос сохранения события в транзакции, предварительно проверив,
+// что занятое событие не пересекается с другими занятыми событиями пользователя.
+// Одиночные события дополнительно защищены ограничением исключения events_busy_no_overlap,
+// повторяющиеся - транзакционной advisory-блокировкой по пользователю.
+func (r *Repository) execChecked(ctx context.Context, event *storage.Event, query string, args ...any) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return errors.Wrap(err, "can't begin transaction")
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if err = checkConflict(ctx, tx, event); err != nil {
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		if isExclusionViolation(err) {
+			return errors.Wrap(storage.ErrDateBusy, "overlaps with another event")
+		}
+
+		return errors.Wrap(err, "can't execute sql query")
+	}
+
+	// обновление несуществующего события не затрагивает ни одной строки
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(storage.ErrEventNotFound, "event with ID %s", event.ID)
+	}
+
+	return errors.Wrap(tx.Commit(ctx), "can't commit transaction")
+}
+
+// checkConflict ищет занятые события пользователя, пересекающиеся с сохраняемым событием.
+func checkConflict(ctx context.Context, tx pgx.Tx, event *storage.Event) error {
+	if !event.Busy {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", event.UserID); err != nil {
+		return errors.Wrap(err, "can't acquire user lock")
+	}
+
+	end, err := event.RecurrenceEnd()
+	if err != nil {
+		return err
+	}
+
+	if end == nil {
+		horizon := event.StartsAt.Add(storage.ConflictHorizon)
+		end = &horizon
+	}
+
+	where := sq.And{
+		sq.Eq{"user_id": event.UserID},
+		sq.Eq{"busy": true},
+		sq.Lt{"starts_at": end},
+		sq.Or{
+			sq.Gt{"ends_at": event.StartsAt},
+			sq.And{
+				sq.NotEq{"rrule": ""},
+				sq.Or{sq.Eq{"recurrence_ends_at": nil}, sq.Gt{"recurrence_ends_at": event.StartsAt}},
+			},
+		},
+	}
+
+	if event.ID != "" {
+		where = append(where, sq.NotEq{"id": event.ID})
+	}
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(eventColumns...).
+		From(eventsTable).
+		Where(where)
+
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return errors.Wrap(err, "[sqlstorage::UpdateEvent]: can't execute sql query")
+		return errors.Wrap(err, "can't build sql query")
+	}
+
+	var candidates []*storage.Event
+
+	if err = pgxscan.Select(ctx, tx, &candidates, query, args...); err != nil {
+		return errors.Wrap(err, "can't execute sql query")
+	}
+
+	conflict, err := storage.FindConflict(event, candidates)
+	if err != nil {
+		return err
+	}
+
+	if conflict != nil {
+		return errors.Wrapf(storage.ErrDateBusy, "overlaps with event %s", conflict.ID)
 	}
 
 	return nil
@@ -104,120 +232,335 @@ func (r *Repository) DeleteEvent(ctx context.Context, eventID string) error {
 		return errors.Wrap(err, "[sqlstorage::DeleteEvent]: can't build sql query")
 	}
 
-	_, err = r.pool.Exec(ctx, query, args...)
+	tag, err := r.pool.Exec(ctx, query, args...)
 	if err != nil {
 		return errors.Wrap(err, "[sqlstorage::DeleteEvent]: can't execute sql query")
 	}
 
+	if tag.RowsAffected() == 0 {
+		return errors.Wrapf(storage.ErrEventNotFound, "[sqlstorage::DeleteEvent]: event with ID %s", eventID)
+	}
+
 	return nil
 }
 
+// ReadEvent читает событие по ID.
+func (r *Repository) ReadEvent(ctx context.Context, eventID string) (*storage.Event, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(eventColumns...).
+		From(eventsTable).
+		Where(sq.Eq{"id": eventID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ReadEvent]: can't build sql query")
+	}
+
+	var event storage.Event
+
+	if err = pgxscan.Get(ctx, r.pool, &event, query, args...); err != nil {
+		if pgxscan.NotFound(err) {
+			return nil, errors.Wrapf(storage.ErrEventNotFound, "[sqlstorage::ReadEvent]: event with ID %s", eventID)
+		}
+		return nil, errors.Wrap(err, "[sqlstorage::ReadEvent]: can't execute sql query")
+	}
+
+	if err = loadAttendees(ctx, r.pool, []*storage.Event{&event}); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ReadEvent]")
+	}
+
+	return &event, nil
+}
+
 // ReadDailyEvents читает события за указанную дату.
-func (r *Repository) ReadDailyEvents(ctx context.Context, userID string, date time.Time) ([]*storage.Event, error) {
+func (r *Repository) ReadDailyEvents(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	end := start.AddDate(0, 0, 1)
+
+	events, err := r.readEvents(ctx, visibleTo(userID, calendarIDs), start, end)
+
+	return events, errors.Wrap(err, "[sqlstorage::ReadDailyEvents]")
+}
+
+// ReadWeeklyEvents читает события за неделю, начиная с указанной даты.
+func (r *Repository) ReadWeeklyEvents(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
 	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-	end := start.Add(24 * time.Hour)
+	end := start.AddDate(0, 0, 7)
+
+	events, err := r.readEvents(ctx, visibleTo(userID, calendarIDs), start, end)
+
+	return events, errors.Wrap(err, "[sqlstorage::ReadWeeklyEvents]")
+}
+
+// ReadMonthlyEvents читает события за месяц, начиная с указанной даты.
+func (r *Repository) ReadMonthlyEvents(
+	ctx context.Context,
+	userID string,
+	date time.Time,
+	calendarIDs []string,
+) ([]*storage.Event, error) {
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	end := time.Date(date.Year(), date.Month()+1, date.Day()+1, 0, 0, 0, 0, date.Location())
+
+	events, err := r.readEvents(ctx, visibleTo(userID, calendarIDs), start, end)
+
+	return events, errors.Wrap(err, "[sqlstorage::ReadMonthlyEvents]")
+}
+
+// ReadEventsInRange читает события указанных пользователей, пересекающиеся с интервалом [from, to):
+// собственные и те, приглашение на которые пользователь принял.
+func (r *Repository) ReadEventsInRange(
+	ctx context.Context,
+	userIDs []string,
+	from, to time.Time,
+) ([]*storage.Event, error) {
+	accepted, acceptedArgs, err := sq.Select("event_id").
+		From(attendeesTable).
+		Where(sq.Eq{"user_id": userIDs, "status": storage.RSVPStatusAccepted}).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ReadEventsInRange]: can't build sql query")
+	}
+
+	owners := sq.Or{
+		sq.Eq{"user_id": userIDs},
+		sq.Expr("id IN ("+accepted+")", acceptedArgs...),
+	}
+
+	events, err := r.readEvents(ctx, owners, from, to)
+
+	return events, errors.Wrap(err, "[sqlstorage::ReadEventsInRange]")
+}
+
+// ListEvents читает страницу событий пользователя по параметрам query. Выборка продолжается после
+// курсора сравнением кортежей (starts_at, id), что позволяет использовать индекс events_user_starts_at_id_idx.
+func (r *Repository) ListEvents(ctx context.Context, query *storage.ListEventsQuery) ([]*storage.Event, error) {
+	where := sq.And{sq.Eq{"user_id": query.UserID}}
+
+	if len(query.CalendarIDs) > 0 {
+		where = append(where, sq.Eq{"calendar_id": query.CalendarIDs})
+	}
+
+	if !query.To.IsZero() {
+		where = append(where, sq.Lt{"starts_at": query.To})
+	}
+
+	if !query.From.IsZero() {
+		where = append(where, sq.Or{
+			sq.Gt{"ends_at": query.From},
+			sq.And{
+				sq.NotEq{"rrule": ""},
+				sq.Or{sq.Eq{"recurrence_ends_at": nil}, sq.Gt{"recurrence_ends_at": query.From}},
+			},
+		})
+	}
+
+	if query.Query != "" {
+		pattern := "%" + likeEscaper.Replace(query.Query) + "%"
+		where = append(where, sq.Or{sq.ILike{"title": pattern}, sq.ILike{"description": pattern}})
+	}
+
+	order := "ASC"
+	if query.Order == storage.SortOrderDesc {
+		order = "DESC"
+	}
+
+	if query.After != nil {
+		comparison := ">"
+		if query.Order == storage.SortOrderDesc {
+			comparison = "<"
+		}
+
+		where = append(where, sq.Expr("(starts_at, id) "+comparison+" (?, ?)", query.After.StartsAt, query.After.ID))
+	}
 
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
-		Select("id", "title", "starts_at", "ends_at", "description", "user_id", "notify_interval").
+		Select(eventColumns...).
 		From(eventsTable).
-		Where(sq.And{
-			sq.Eq{"user_id": userID},
-			sq.Gt{"ends_at": start},
-			sq.Lt{"starts_at": end},
-		})
+		Where(where).
+		OrderBy("starts_at "+order, "id "+order).
+		Limit(uint64(query.Limit)) //nolint:gosec
 
-	query, args, err := builder.ToSql()
+	sqlQuery, args, err := builder.ToSql()
 	if err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadDailyEvents]: can't build sql query")
+		return nil, errors.Wrap(err, "[sqlstorage::ListEvents]: can't build sql query")
 	}
 
 	var events []*storage.Event
 
-	if err = pgxscan.Select(ctx, r.pool, &events, query, args...); err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadDailyEvents]: can't execute sql query")
+	if err = pgxscan.Select(ctx, r.pool, &events, sqlQuery, args...); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ListEvents]: can't execute sql query")
 	}
 
 	return events, nil
 }
 
-// ReadWeeklyEvents читает события за неделю, начиная с указанной даты.
-func (r *Repository) ReadWeeklyEvents(ctx context.Context, userID string, date time.Time) ([]*storage.Event, error) {
-	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-	end := start.Add(7 * 24 * time.Hour)
+// SearchEvents ищет до limit событий пользователя в календарях calendarIDs, содержащих хотя бы одно
+// из слов query (см. storage.SearchTerms) в названии или описании, с помощью полнотекстового индекса
+// events_search_vector_idx. События упорядочены по убыванию ts_rank, затем по началу.
+func (r *Repository) SearchEvents(
+	ctx context.Context,
+	userID, query string,
+	calendarIDs []string,
+	limit int,
+) ([]*storage.Event, error) {
+	terms := storage.SearchTerms(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	// слова состоят только из букв и цифр, поэтому их можно объединить в tsquery без экранирования
+	tsQuery := strings.Join(terms, " | ")
+
+	where := sq.And{
+		sq.Eq{"user_id": userID},
+		sq.Expr("search_vector @@ to_tsquery('simple', ?)", tsQuery),
+	}
+
+	if len(calendarIDs) > 0 {
+		where = append(where, sq.Eq{"calendar_id": calendarIDs})
+	}
 
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
-		Select("id", "title", "starts_at", "ends_at", "description", "user_id", "notify_interval").
+		Select(eventColumns...).
 		From(eventsTable).
-		Where(sq.And{
-			sq.Eq{"user_id": userID},
-			sq.Gt{"ends_at": start},
-			sq.Lt{"starts_at": end},
-		})
+		Where(where).
+		OrderByClause("ts_rank(search_vector, to_tsquery('simple', ?)) DESC", tsQuery).
+		OrderBy("starts_at", "id").
+		Limit(uint64(limit)) //nolint:gosec
 
-	query, args, err := builder.ToSql()
+	sqlQuery, args, err := builder.ToSql()
 	if err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadWeeklyEvents]: can't build sql query")
+		return nil, errors.Wrap(err, "[sqlstorage::SearchEvents]: can't build sql query")
 	}
 
 	var events []*storage.Event
 
-	if err = pgxscan.Select(ctx, r.pool, &events, query, args...); err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadWeeklyEvents]: can't execute sql query")
+	if err = pgxscan.Select(ctx, r.pool, &events, sqlQuery, args...); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::SearchEvents]: can't execute sql query")
 	}
 
 	return events, nil
 }
 
-// ReadMonthlyEvents читает события за месяц, начиная с указанной даты.
-func (r *Repository) ReadMonthlyEvents(ctx context.Context, userID string, date time.Time) ([]*storage.Event, error) {
-	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-	end := time.Date(date.Year(), date.Month()+1, date.Day()+1, 0, 0, 0, 0, date.Location())
-
+// readEvents читает события, отобранные условием owners и пересекающиеся с интервалом [start, end),
+// разворачивая повторяющиеся события во вхождения.
+func (r *Repository) readEvents(
+	ctx context.Context,
+	owners sq.Sqlizer,
+	start, end time.Time,
+) ([]*storage.Event, error) {
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
-		Select("id", "title", "starts_at", "ends_at", "description", "user_id", "notify_interval").
+		Select(eventColumns...).
 		From(eventsTable).
 		Where(sq.And{
-			sq.Eq{"user_id": userID},
-			sq.Gt{"ends_at": start},
+			owners,
 			sq.Lt{"starts_at": end},
-		})
+			sq.Or{
+				sq.Gt{"ends_at": start},
+				sq.And{
+					sq.NotEq{"rrule": ""},
+					sq.Or{sq.Eq{"recurrence_ends_at": nil}, sq.Gt{"recurrence_ends_at": start}},
+				},
+			},
+		}).
+		OrderBy("starts_at")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadMonthlyEvents]: can't build sql query")
+		return nil, errors.Wrap(err, "can't build sql query")
 	}
 
 	var events []*storage.Event
 
 	if err = pgxscan.Select(ctx, r.pool, &events, query, args...); err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadMonthlyEvents]: can't execute sql query")
+		return nil, errors.Wrap(err, "can't execute sql query")
 	}
 
-	return events, nil
+	if err = loadAttendees(ctx, r.pool, events); err != nil {
+		return nil, err
+	}
+
+	return storage.ExpandEvents(events, start, end)
 }
 
-// ReadEventsToNotify читает события, у которых (starts_at - now()) <= notify_interval.
-func (r *Repository) ReadEventsToNotify(ctx context.Context) ([]*storage.Event, error) {
+// EnqueueNotifications в одной транзакции помещает в outbox уведомления о событиях,
+// у которых (starts_at - now()) <= notify_interval, и отмечает события как обработанные.
+// Для повторяющихся событий в outbox попадают вхождения, о которых еще не было уведомления.
+// Уведомления получают владелец события и участники, не отказавшиеся от приглашения.
+func (r *Repository) EnqueueNotifications(ctx context.Context) (int, error) {
 	now := time.Now().UTC()
 
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "[sqlstorage::EnqueueNotifications]: can't begin transaction")
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	events, err := dueEvents(ctx, tx, now)
+	if err != nil {
+		return 0, errors.Wrap(err, "[sqlstorage::EnqueueNotifications]")
+	}
+
+	occurrences, err := dueOccurrences(ctx, tx, now)
+	if err != nil {
+		return 0, errors.Wrap(err, "[sqlstorage::EnqueueNotifications]")
+	}
+
+	events = append(events, occurrences...)
+
+	if err = loadAttendees(ctx, tx, events); err != nil {
+		return 0, errors.Wrap(err, "[sqlstorage::EnqueueNotifications]")
+	}
+
+	if err = insertNotifications(ctx, tx, events, now); err != nil {
+		return 0, errors.Wrap(err, "[sqlstorage::EnqueueNotifications]")
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return 0, errors.Wrap(err, "[sqlstorage::EnqueueNotifications]: can't commit transaction")
+	}
+
+	return len(events), nil
+}
+
+// dueEvents выбирает и отмечает обработанными одиночные события, о которых пора уведомить.
+// Строки блокируются с SKIP LOCKED, чтобы параллельные планировщики не дублировали уведомления.
+func dueEvents(ctx context.Context, tx pgx.Tx, now time.Time) ([]*storage.Event, error) {
 	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 		Select("id", "title", "starts_at", "user_id").
 		From(eventsTable).
 		Where(sq.And{
+			sq.Eq{"rrule": ""},
 			sq.Eq{"processed": false},
 			sq.LtOrEq{"starts_at - notify_interval": now},
 			sq.GtOrEq{"ends_at": now},
-		})
+		}).
+		Suffix("FOR UPDATE SKIP LOCKED")
 
 	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadEventsToNotify]: can't build sql query")
+		return nil, errors.Wrap(err, "can't build sql query")
 	}
 
 	var events []*storage.Event
 
-	if err = pgxscan.Select(ctx, r.pool, &events, query, args...); err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadEventsToNotify]: can't execute sql query")
+	if err = pgxscan.Select(ctx, tx, &events, query, args...); err != nil {
+		return nil, errors.Wrap(err, "can't execute sql query")
+	}
+
+	if len(events) == 0 {
+		return nil, nil
 	}
 
 	ids := make([]string, 0, len(events))
@@ -232,13 +575,203 @@ func (r *Repository) ReadEventsToNotify(ctx context.Context) ([]*storage.Event,
 
 	query, args, err = updBuilder.ToSql()
 	if err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadEventsToNotify]: can't build sql query")
+		return nil, errors.Wrap(err, "can't build sql query")
 	}
 
-	_, err = r.pool.Exec(ctx, query, args...)
-	if err != nil {
-		return nil, errors.Wrap(err, "[sqlstorage::ReadEventsToNotify]: can't execute sql query")
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		return nil, errors.Wrap(err, "can't execute sql query")
 	}
 
 	return events, nil
 }
+
+// dueOccurrences выбирает вхождения повторяющихся событий, о которых пора уведомить,
+// и запоминает начало последнего уведомленного вхождения каждой серии.
+func dueOccurrences(ctx context.Context, tx pgx.Tx, now time.Time) ([]*storage.Event, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(append(eventColumns, "notified_occurrence_at")...).
+		From(eventsTable).
+		Where(sq.And{
+			sq.NotEq{"rrule": ""},
+			sq.LtOrEq{"starts_at - notify_interval": now},
+			sq.Or{sq.Eq{"recurrence_ends_at": nil}, sq.GtOrEq{"recurrence_ends_at": now}},
+		}).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "can't build sql query")
+	}
+
+	var series []*storage.Event
+
+	if err = pgxscan.Select(ctx, tx, &series, query, args...); err != nil {
+		return nil, errors.Wrap(err, "can't execute sql query")
+	}
+
+	var result []*storage.Event
+
+	for _, event := range series {
+		occurrences, err := event.DueOccurrences(now)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(occurrences) == 0 {
+			continue
+		}
+
+		updBuilder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+			Update(eventsTable).
+			Set("notified_occurrence_at", occurrences[len(occurrences)-1].StartsAt).
+			Where(sq.Eq{"id": event.ID})
+
+		query, args, err = updBuilder.ToSql()
+		if err != nil {
+			return nil, errors.Wrap(err, "can't build sql query")
+		}
+
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			return nil, errors.Wrap(err, "can't execute sql query")
+		}
+
+		result = append(result, occurrences...)
+	}
+
+	return result, nil
+}
+
+// insertNotifications записывает уведомления о событиях в outbox.
+// Повторная запись уведомления того же получателя о том же вхождении игнорируется.
+func insertNotifications(ctx context.Context, tx pgx.Tx, events []*storage.Event, now time.Time) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(outboxTable).
+		Columns("event_id", "title", "starts_at", "user_id", "created_at").
+		Suffix("ON CONFLICT (event_id, starts_at, user_id) DO NOTHING")
+
+	for _, event := range events {
+		for _, notification := range storage.NewNotifications(event, now) {
+			builder = builder.Values(
+				notification.EventID, notification.Title, notification.StartsAt, notification.UserID, notification.CreatedAt,
+			)
+		}
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "can't build sql query")
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+
+	return errors.Wrap(err, "can't execute sql query")
+}
+
+// ReadPendingNotifications читает до limit неотправленных уведомлений из outbox в порядке создания.
+func (r *Repository) ReadPendingNotifications(ctx context.Context, limit int) ([]*storage.Notification, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "event_id", "title", "starts_at", "user_id", "created_at", "sent_at").
+		From(outboxTable).
+		Where(sq.Eq{"sent_at": nil}).
+		OrderBy("id").
+		Limit(uint64(limit)) //nolint:gosec
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ReadPendingNotifications]: can't build sql query")
+	}
+
+	var notifications []*storage.Notification
+
+	if err = pgxscan.Select(ctx, r.pool, &notifications, query, args...); err != nil {
+		return nil, errors.Wrap(err, "[sqlstorage::ReadPendingNotifications]: can't execute sql query")
+	}
+
+	return notifications, nil
+}
+
+// MarkNotificationsSent отмечает уведомления outbox как отправленные.
+func (r *Repository) MarkNotificationsSent(ctx context.Context, ids []int64) error {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update(outboxTable).
+		Set("sent_at", time.Now().UTC()).
+		Where(sq.And{sq.Eq{"id": ids}, sq.Eq{"sent_at": nil}})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::MarkNotificationsSent]: can't build sql query")
+	}
+
+	if _, err = r.pool.Exec(ctx, query, args...); err != nil {
+		return errors.Wrap(err, "[sqlstorage::MarkNotificationsSent]: can't execute sql query")
+	}
+
+	return nil
+}
+
+// DeleteEventsEndedBefore удаляет события, закончившиеся раньше указанного момента, пачками
+// по purgeBatchSize, чтобы не держать долгие блокировки. Повторяющиеся события удаляются
+// после окончания последнего вхождения, бесконечные серии не удаляются.
+func (r *Repository) DeleteEventsEndedBefore(ctx context.Context, before time.Time) (int64, error) {
+	subquery := sq.Select("id").
+		From(eventsTable).
+		Where(sq.Or{
+			sq.And{sq.Eq{"rrule": ""}, sq.Lt{"ends_at": before}},
+			sq.And{sq.NotEq{"rrule": ""}, sq.Lt{"recurrence_ends_at": before}},
+		}).
+		Limit(purgeBatchSize)
+
+	subquerySQL, subqueryArgs, err := subquery.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "[sqlstorage::DeleteEventsEndedBefore]: can't build sql query")
+	}
+
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete(eventsTable).
+		Where("id IN ("+subquerySQL+")", subqueryArgs...)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "[sqlstorage::DeleteEventsEndedBefore]: can't build sql query")
+	}
+
+	var total int64
+
+	for {
+		tag, inErr := r.pool.Exec(ctx, query, args...)
+		if inErr != nil {
+			return total, errors.Wrap(inErr, "[sqlstorage::DeleteEventsEndedBefore]: can't execute sql query")
+		}
+
+		total += tag.RowsAffected()
+
+		if tag.RowsAffected() < purgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// setRecurrence явно проставляет поля повторения, чтобы обновление могло их сбросить,
+// а также окончание последнего вхождения серии, по которому фильтруются выборки.
+func setRecurrence(m map[string]any, event *storage.Event) error {
+	recurrenceEnd, err := event.RecurrenceEnd()
+	if err != nil {
+		return err
+	}
+
+	m["rrule"] = event.RRule
+	m["exdates"] = event.ExDates
+	m["recurrence_ends_at"] = recurrenceEnd
+
+	return nil
+}
+
+// setAvailability явно проставляет признаки занятости, чтобы обновление могло их сбросить.
+func setAvailability(m map[string]any, event *storage.Event) {
+	m["tentative"] = event.Tentative
+	m["busy"] = event.Busy
+}
@@ -0,0 +1,55 @@
+package sqlstorage
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/pkg/errors"
+)
+
+const userSettingsTable = "user_settings"
+
+// SetUserTimeZone сохраняет IANA часовой пояс пользователя.
+func (r *Repository) SetUserTimeZone(ctx context.Context, userID, timeZone string) error {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert(userSettingsTable).
+		Columns("user_id", "time_zone").
+		Values(userID, timeZone).
+		Suffix("ON CONFLICT (user_id) DO UPDATE SET time_zone = EXCLUDED.time_zone")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "[sqlstorage::SetUserTimeZone]: can't build sql query")
+	}
+
+	if _, err = r.pool.Exec(ctx, query, args...); err != nil {
+		return errors.Wrap(err, "[sqlstorage::SetUserTimeZone]: can't execute sql query")
+	}
+
+	return nil
+}
+
+// ReadUserTimeZone читает IANA часовой пояс пользователя. Если пояс не задан, возвращает пустую строку.
+func (r *Repository) ReadUserTimeZone(ctx context.Context, userID string) (string, error) {
+	builder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("time_zone").
+		From(userSettingsTable).
+		Where(sq.Eq{"user_id": userID})
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return "", errors.Wrap(err, "[sqlstorage::ReadUserTimeZone]: can't build sql query")
+	}
+
+	var timeZone string
+
+	if err = pgxscan.Get(ctx, r.pool, &timeZone, query, args...); err != nil {
+		if pgxscan.NotFound(err) {
+			return "", nil
+		}
+		return "", errors.Wrap(err, "[sqlstorage::ReadUserTimeZone]: can't execute sql query")
+	}
+
+	return timeZone, nil
+}
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+    ADD COLUMN rrule                  TEXT                     NOT NULL DEFAULT '',
+    ADD COLUMN exdates                TIMESTAMP WITH TIME ZONE[] NULL,
+    ADD COLUMN recurrence_ends_at     TIMESTAMP WITH TIME ZONE NULL,
+    ADD COLUMN notified_occurrence_at TIMESTAMP WITH TIME ZONE NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events
+    DROP COLUMN IF EXISTS notified_occurrence_at,
+    DROP COLUMN IF EXISTS recurrence_ends_at,
+    DROP COLUMN IF EXISTS exdates,
+    DROP COLUMN IF EXISTS rrule;
+-- +goose StatementEnd
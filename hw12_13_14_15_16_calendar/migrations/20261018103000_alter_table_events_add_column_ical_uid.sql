@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+    ADD COLUMN ical_uid TEXT NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX events_user_id_ical_uid_idx ON events (user_id, ical_uid) WHERE ical_uid <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS events_user_id_ical_uid_idx;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS ical_uid;
+-- +goose StatementEnd
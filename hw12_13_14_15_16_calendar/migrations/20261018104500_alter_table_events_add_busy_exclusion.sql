@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE events
+    ADD COLUMN tentative BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN busy      BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE events
+    ADD CONSTRAINT events_busy_no_overlap
+        EXCLUDE USING gist (user_id WITH =, tstzrange(starts_at, ends_at) WITH &&)
+        WHERE (busy AND rrule = '');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events
+    DROP CONSTRAINT IF EXISTS events_busy_no_overlap;
+
+ALTER TABLE events
+    DROP COLUMN IF EXISTS busy,
+    DROP COLUMN IF EXISTS tentative;
+-- +goose StatementEnd
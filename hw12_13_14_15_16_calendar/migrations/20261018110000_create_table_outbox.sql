@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE outbox
+(
+    id         BIGSERIAL PRIMARY KEY,
+    event_id   UUID                     NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    title      TEXT                     NOT NULL,
+    starts_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    user_id    UUID                     NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    sent_at    TIMESTAMP WITH TIME ZONE NULL
+);
+
+CREATE UNIQUE INDEX outbox_event_id_starts_at_idx ON outbox (event_id, starts_at);
+
+CREATE INDEX outbox_pending_idx ON outbox (id) WHERE sent_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE outbox;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX events_user_starts_at_id_idx ON events (user_id, starts_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS events_user_starts_at_id_idx;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE calendar_grants
+(
+    owner_id   UUID                     NOT NULL,
+    grantee_id UUID                     NOT NULL,
+    permission TEXT                     NOT NULL CHECK (permission IN ('free-busy', 'read', 'write')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (owner_id, grantee_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE calendar_grants;
+-- +goose StatementEnd
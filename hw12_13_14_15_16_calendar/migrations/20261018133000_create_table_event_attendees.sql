@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE event_attendees
+(
+    event_id UUID NOT NULL REFERENCES events (id) ON DELETE CASCADE,
+    user_id  UUID NOT NULL,
+    role     TEXT NOT NULL CHECK (role IN ('required', 'optional')),
+    status   TEXT NOT NULL DEFAULT 'needs-action'
+        CHECK (status IN ('needs-action', 'accepted', 'declined', 'tentative')),
+    PRIMARY KEY (event_id, user_id)
+);
+
+CREATE INDEX event_attendees_user_id_status_idx ON event_attendees (user_id, status);
+
+DROP INDEX outbox_event_id_starts_at_idx;
+
+CREATE UNIQUE INDEX outbox_event_id_starts_at_user_id_idx ON outbox (event_id, starts_at, user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX outbox_event_id_starts_at_user_id_idx;
+
+DELETE FROM outbox o
+WHERE EXISTS (SELECT 1 FROM events e WHERE e.id = o.event_id AND e.user_id <> o.user_id);
+
+CREATE UNIQUE INDEX outbox_event_id_starts_at_idx ON outbox (event_id, starts_at);
+
+DROP TABLE event_attendees;
+-- +goose StatementEnd
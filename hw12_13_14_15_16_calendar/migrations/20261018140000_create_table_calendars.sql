@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE calendars
+(
+    id                      UUID PRIMARY KEY         DEFAULT gen_random_uuid(),
+    user_id                 UUID                     NOT NULL,
+    name                    TEXT                     NOT NULL,
+    color                   TEXT                     NOT NULL DEFAULT '',
+    default_notify_interval INTERVAL                 NOT NULL DEFAULT '0',
+    is_default              BOOLEAN                  NOT NULL DEFAULT FALSE,
+    created_at              TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX calendars_user_id_default_idx ON calendars (user_id) WHERE is_default;
+
+INSERT INTO calendars (user_id, name, is_default)
+SELECT DISTINCT user_id, 'Default', TRUE
+FROM events;
+
+ALTER TABLE events
+    ADD COLUMN calendar_id UUID NULL REFERENCES calendars (id) ON DELETE CASCADE;
+
+UPDATE events e
+SET calendar_id = c.id
+FROM calendars c
+WHERE c.user_id = e.user_id
+  AND c.is_default;
+
+ALTER TABLE events
+    ALTER COLUMN calendar_id SET NOT NULL;
+
+CREATE INDEX events_calendar_id_idx ON events (calendar_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events
+    DROP COLUMN IF EXISTS calendar_id;
+
+DROP TABLE calendars;
+-- +goose StatementEnd